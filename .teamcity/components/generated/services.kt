@@ -17,6 +17,7 @@ var services = mapOf(
         "blueprints" to "Blueprints",
         "bot" to "Bot",
         "cdn" to "CDN",
+        "chaosstudio" to "Chaos Studio",
         "cognitive" to "Cognitive Services",
         "communication" to "Communication",
         "compute" to "Compute",
@@ -103,6 +104,7 @@ var services = mapOf(
         "signalr" to "SignalR",
         "springcloud" to "Spring Cloud",
         "storage" to "Storage",
+        "storagemover" to "Storage Mover",
         "streamanalytics" to "Stream Analytics",
         "subscription" to "Subscription",
         "synapse" to "Synapse",
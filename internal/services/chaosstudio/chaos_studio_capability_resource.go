@@ -0,0 +1,160 @@
+package chaosstudio
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/chaosstudio/2022-07-01-preview/capabilities"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/chaosstudio/2022-07-01-preview/targets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ChaosStudioCapabilityResource struct{}
+
+var _ sdk.Resource = ChaosStudioCapabilityResource{}
+
+type ChaosStudioCapabilityResourceModel struct {
+	ChaosStudioTargetId string `tfschema:"chaos_studio_target_id"`
+	CapabilityType      string `tfschema:"capability_type"`
+	Urn                 string `tfschema:"urn"`
+}
+
+func (r ChaosStudioCapabilityResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"chaos_studio_target_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: targets.ValidateTargetID,
+		},
+
+		// Example: PodChaos-2.1 or Shutdown-1.0
+		"capability_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r ChaosStudioCapabilityResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"urn": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ChaosStudioCapabilityResource) ModelObject() interface{} {
+	return &ChaosStudioCapabilityResourceModel{}
+}
+
+func (r ChaosStudioCapabilityResource) ResourceType() string {
+	return "azurerm_chaos_studio_capability"
+}
+
+func (r ChaosStudioCapabilityResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return capabilities.ValidateCapabilityID
+}
+
+func (r ChaosStudioCapabilityResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ChaosStudioCapabilityResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.ChaosStudio.CapabilitiesClient
+
+			targetId, err := targets.ParseTargetID(model.ChaosStudioTargetId)
+			if err != nil {
+				return err
+			}
+
+			id := capabilities.NewCapabilityID(targetId.SubscriptionId, targetId.ResourceGroupName, targetId.ParentProviderNamespace, targetId.ParentResourceType, targetId.ParentResourceName, targetId.TargetName, model.CapabilityType)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := capabilities.Capability{
+				Properties: &capabilities.CapabilityProperties{},
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r ChaosStudioCapabilityResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ChaosStudio.CapabilitiesClient
+
+			id, err := capabilities.ParseCapabilityID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ChaosStudioCapabilityResourceModel{
+				ChaosStudioTargetId: targets.NewTargetID(id.SubscriptionId, id.ResourceGroupName, id.ParentProviderNamespace, id.ParentResourceType, id.ParentResourceName, id.TargetName).ID(),
+				CapabilityType:      id.CapabilityName,
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil && props.Urn != nil {
+					state.Urn = *props.Urn
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r ChaosStudioCapabilityResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ChaosStudio.CapabilitiesClient
+
+			id, err := capabilities.ParseCapabilityID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
@@ -0,0 +1,575 @@
+package chaosstudio
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/chaosstudio/2022-07-01-preview/targets"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/chaosstudio/sdk/2022-07-01-preview/experiments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+const (
+	chaosStudioActionTypeContinuous = "continuous"
+	chaosStudioActionTypeDelay      = "delay"
+	chaosStudioActionTypeDiscrete   = "discrete"
+
+	chaosStudioDelayActionUrn = "urn:csci:microsoft:chaosStudio:timedDelay/1.0"
+)
+
+type ChaosStudioExperimentResource struct{}
+
+var _ sdk.ResourceWithUpdate = ChaosStudioExperimentResource{}
+
+type ChaosStudioExperimentResourceModel struct {
+	Name              string                               `tfschema:"name"`
+	ResourceGroupName string                               `tfschema:"resource_group_name"`
+	Location          string                               `tfschema:"location"`
+	Identity          []identity.ModelSystemAssigned       `tfschema:"identity"`
+	Selector          []ChaosStudioExperimentSelectorModel `tfschema:"selector"`
+	Step              []ChaosStudioExperimentStepModel     `tfschema:"step"`
+	Tags              map[string]string                    `tfschema:"tags"`
+}
+
+type ChaosStudioExperimentSelectorModel struct {
+	Name                 string   `tfschema:"name"`
+	ChaosStudioTargetIds []string `tfschema:"chaos_studio_target_ids"`
+}
+
+type ChaosStudioExperimentStepModel struct {
+	Name   string                             `tfschema:"name"`
+	Branch []ChaosStudioExperimentBranchModel `tfschema:"branch"`
+}
+
+type ChaosStudioExperimentBranchModel struct {
+	Name   string                             `tfschema:"name"`
+	Action []ChaosStudioExperimentActionModel `tfschema:"action"`
+}
+
+type ChaosStudioExperimentActionModel struct {
+	ActionType   string            `tfschema:"action_type"`
+	Urn          string            `tfschema:"urn"`
+	Duration     string            `tfschema:"duration"`
+	SelectorName string            `tfschema:"selector_name"`
+	Parameters   map[string]string `tfschema:"parameters"`
+}
+
+func (r ChaosStudioExperimentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"identity": commonschema.SystemAssignedIdentityRequired(),
+
+		"selector": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"chaos_studio_target_ids": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						MinItems: 1,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: targets.ValidateTargetID,
+						},
+					},
+				},
+			},
+		},
+
+		"step": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"branch": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"action": {
+									Type:     pluginsdk.TypeList,
+									Required: true,
+									Elem: &pluginsdk.Resource{
+										Schema: map[string]*pluginsdk.Schema{
+											"action_type": {
+												Type:     pluginsdk.TypeString,
+												Required: true,
+												ValidateFunc: validation.StringInSlice([]string{
+													chaosStudioActionTypeContinuous,
+													chaosStudioActionTypeDelay,
+													chaosStudioActionTypeDiscrete,
+												}, false),
+											},
+
+											// Example: urn:csci:microsoft:azureKubernetesServiceChaosMesh:podChaos/2.1
+											"urn": {
+												Type:         pluginsdk.TypeString,
+												Optional:     true,
+												Computed:     true,
+												ValidateFunc: validation.StringIsNotEmpty,
+											},
+
+											"duration": {
+												Type:         pluginsdk.TypeString,
+												Optional:     true,
+												ValidateFunc: validate.ISO8601Duration,
+											},
+
+											"selector_name": {
+												Type:         pluginsdk.TypeString,
+												Optional:     true,
+												ValidateFunc: validation.StringIsNotEmpty,
+											},
+
+											"parameters": {
+												Type:     pluginsdk.TypeMap,
+												Optional: true,
+												Elem: &pluginsdk.Schema{
+													Type: pluginsdk.TypeString,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ChaosStudioExperimentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ChaosStudioExperimentResource) ModelObject() interface{} {
+	return &ChaosStudioExperimentResourceModel{}
+}
+
+func (r ChaosStudioExperimentResource) ResourceType() string {
+	return "azurerm_chaos_studio_experiment"
+}
+
+func (r ChaosStudioExperimentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return experiments.ValidateExperimentID
+}
+
+func (r ChaosStudioExperimentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ChaosStudioExperimentResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.ChaosStudio.ExperimentsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			id := experiments.NewExperimentID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			expandedIdentity, err := identity.ExpandSystemAssignedFromModel(model.Identity)
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			steps, err := expandChaosStudioExperimentSteps(model.Step)
+			if err != nil {
+				return err
+			}
+
+			payload := experiments.Experiment{
+				Identity: expandedIdentity,
+				Location: location.Normalize(model.Location),
+				Properties: experiments.ExperimentProperties{
+					Selectors: expandChaosStudioExperimentSelectors(model.Selector),
+					Steps:     steps,
+				},
+				Tags: &model.Tags,
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r ChaosStudioExperimentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ChaosStudio.ExperimentsClient
+
+			id, err := experiments.ParseExperimentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ChaosStudioExperimentResourceModel{
+				Name:              id.ExperimentName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Identity = identity.FlattenSystemAssignedToModel(model.Identity)
+				state.Selector = flattenChaosStudioExperimentSelectors(model.Properties.Selectors)
+
+				steps, err := flattenChaosStudioExperimentSteps(model.Properties.Steps)
+				if err != nil {
+					return err
+				}
+				state.Step = steps
+
+				if model.Tags != nil {
+					state.Tags = *model.Tags
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r ChaosStudioExperimentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ChaosStudio.ExperimentsClient
+
+			id, err := experiments.ParseExperimentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ChaosStudioExperimentResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			payload := *existing.Model
+
+			if metadata.ResourceData.HasChange("identity") {
+				expandedIdentity, err := identity.ExpandSystemAssignedFromModel(model.Identity)
+				if err != nil {
+					return fmt.Errorf("expanding `identity`: %+v", err)
+				}
+				payload.Identity = expandedIdentity
+			}
+
+			if metadata.ResourceData.HasChange("selector") {
+				payload.Properties.Selectors = expandChaosStudioExperimentSelectors(model.Selector)
+			}
+
+			if metadata.ResourceData.HasChange("step") {
+				steps, err := expandChaosStudioExperimentSteps(model.Step)
+				if err != nil {
+					return err
+				}
+				payload.Properties.Steps = steps
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = &model.Tags
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r ChaosStudioExperimentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ChaosStudio.ExperimentsClient
+
+			id, err := experiments.ParseExperimentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func expandChaosStudioExperimentSelectors(input []ChaosStudioExperimentSelectorModel) []experiments.Selector {
+	output := make([]experiments.Selector, 0)
+	for _, v := range input {
+		targetReferences := make([]experiments.TargetReference, 0)
+		for _, targetId := range v.ChaosStudioTargetIds {
+			targetReferences = append(targetReferences, experiments.TargetReference{
+				Id:   targetId,
+				Type: experiments.TargetReferenceTypeChaosTarget,
+			})
+		}
+
+		output = append(output, experiments.Selector{
+			Id:      v.Name,
+			Targets: targetReferences,
+			Type:    experiments.SelectorTypeList,
+		})
+	}
+	return output
+}
+
+func flattenChaosStudioExperimentSelectors(input []experiments.Selector) []ChaosStudioExperimentSelectorModel {
+	output := make([]ChaosStudioExperimentSelectorModel, 0)
+	for _, v := range input {
+		targetIds := make([]string, 0)
+		for _, target := range v.Targets {
+			targetId := target.Id
+			if parsed, err := targets.ParseTargetIDInsensitively(target.Id); err == nil {
+				targetId = parsed.ID()
+			}
+			targetIds = append(targetIds, targetId)
+		}
+
+		output = append(output, ChaosStudioExperimentSelectorModel{
+			Name:                 v.Id,
+			ChaosStudioTargetIds: targetIds,
+		})
+	}
+	return output
+}
+
+func expandChaosStudioExperimentSteps(input []ChaosStudioExperimentStepModel) ([]experiments.Step, error) {
+	output := make([]experiments.Step, 0)
+	for _, step := range input {
+		branches := make([]experiments.Branch, 0)
+		for _, branch := range step.Branch {
+			actions := make([]experiments.Action, 0)
+			for i, action := range branch.Action {
+				expanded, err := expandChaosStudioExperimentAction(action)
+				if err != nil {
+					return nil, fmt.Errorf("expanding action %d of the branch %q within the step %q: %+v", i, branch.Name, step.Name, err)
+				}
+				actions = append(actions, expanded)
+			}
+
+			branches = append(branches, experiments.Branch{
+				Name:    branch.Name,
+				Actions: actions,
+			})
+		}
+
+		output = append(output, experiments.Step{
+			Name:     step.Name,
+			Branches: branches,
+		})
+	}
+	return output, nil
+}
+
+func expandChaosStudioExperimentAction(input ChaosStudioExperimentActionModel) (experiments.Action, error) {
+	switch input.ActionType {
+	case chaosStudioActionTypeContinuous:
+		if input.Urn == "" || input.Duration == "" || input.SelectorName == "" {
+			return nil, fmt.Errorf("`urn`, `duration` and `selector_name` must be specified when `action_type` is `%s`", chaosStudioActionTypeContinuous)
+		}
+
+		return experiments.ContinuousAction{
+			Duration:   input.Duration,
+			Name:       input.Urn,
+			Parameters: expandChaosStudioExperimentActionParameters(input.Parameters),
+			SelectorId: input.SelectorName,
+		}, nil
+
+	case chaosStudioActionTypeDelay:
+		if input.Duration == "" {
+			return nil, fmt.Errorf("`duration` must be specified when `action_type` is `%s`", chaosStudioActionTypeDelay)
+		}
+		if input.SelectorName != "" || len(input.Parameters) > 0 {
+			return nil, fmt.Errorf("`selector_name` and `parameters` cannot be specified when `action_type` is `%s`", chaosStudioActionTypeDelay)
+		}
+
+		urn := input.Urn
+		if urn == "" {
+			urn = chaosStudioDelayActionUrn
+		}
+
+		return experiments.DelayAction{
+			Duration: input.Duration,
+			Name:     urn,
+		}, nil
+
+	case chaosStudioActionTypeDiscrete:
+		if input.Urn == "" || input.SelectorName == "" {
+			return nil, fmt.Errorf("`urn` and `selector_name` must be specified when `action_type` is `%s`", chaosStudioActionTypeDiscrete)
+		}
+		if input.Duration != "" {
+			return nil, fmt.Errorf("`duration` cannot be specified when `action_type` is `%s`", chaosStudioActionTypeDiscrete)
+		}
+
+		return experiments.DiscreteAction{
+			Name:       input.Urn,
+			Parameters: expandChaosStudioExperimentActionParameters(input.Parameters),
+			SelectorId: input.SelectorName,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported `action_type` %q", input.ActionType)
+}
+
+func expandChaosStudioExperimentActionParameters(input map[string]string) []experiments.KeyValuePair {
+	keys := make([]string, 0)
+	for k := range input {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	output := make([]experiments.KeyValuePair, 0)
+	for _, k := range keys {
+		output = append(output, experiments.KeyValuePair{
+			Key:   k,
+			Value: input[k],
+		})
+	}
+	return output
+}
+
+func flattenChaosStudioExperimentSteps(input []experiments.Step) ([]ChaosStudioExperimentStepModel, error) {
+	output := make([]ChaosStudioExperimentStepModel, 0)
+	for _, step := range input {
+		branches := make([]ChaosStudioExperimentBranchModel, 0)
+		for _, branch := range step.Branches {
+			actions := make([]ChaosStudioExperimentActionModel, 0)
+			for _, action := range branch.Actions {
+				flattened, err := flattenChaosStudioExperimentAction(action)
+				if err != nil {
+					return nil, fmt.Errorf("flattening the branch %q within the step %q: %+v", branch.Name, step.Name, err)
+				}
+				actions = append(actions, *flattened)
+			}
+
+			branches = append(branches, ChaosStudioExperimentBranchModel{
+				Name:   branch.Name,
+				Action: actions,
+			})
+		}
+
+		output = append(output, ChaosStudioExperimentStepModel{
+			Name:   step.Name,
+			Branch: branches,
+		})
+	}
+	return output, nil
+}
+
+func flattenChaosStudioExperimentAction(input experiments.Action) (*ChaosStudioExperimentActionModel, error) {
+	switch action := input.(type) {
+	case experiments.ContinuousAction:
+		return &ChaosStudioExperimentActionModel{
+			ActionType:   chaosStudioActionTypeContinuous,
+			Urn:          action.Name,
+			Duration:     action.Duration,
+			SelectorName: action.SelectorId,
+			Parameters:   flattenChaosStudioExperimentActionParameters(action.Parameters),
+		}, nil
+
+	case experiments.DelayAction:
+		return &ChaosStudioExperimentActionModel{
+			ActionType: chaosStudioActionTypeDelay,
+			Urn:        action.Name,
+			Duration:   action.Duration,
+		}, nil
+
+	case experiments.DiscreteAction:
+		return &ChaosStudioExperimentActionModel{
+			ActionType:   chaosStudioActionTypeDiscrete,
+			Urn:          action.Name,
+			SelectorName: action.SelectorId,
+			Parameters:   flattenChaosStudioExperimentActionParameters(action.Parameters),
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported action type %+v", input)
+}
+
+func flattenChaosStudioExperimentActionParameters(input []experiments.KeyValuePair) map[string]string {
+	output := make(map[string]string)
+	for _, v := range input {
+		output[v.Key] = v.Value
+	}
+	return output
+}
@@ -0,0 +1,248 @@
+package chaosstudio_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/chaosstudio/sdk/2022-07-01-preview/experiments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ChaosStudioExperimentResource struct{}
+
+func TestAccChaosStudioExperiment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_chaos_studio_experiment", "test")
+	r := ChaosStudioExperimentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("identity.0.principal_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccChaosStudioExperiment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_chaos_studio_experiment", "test")
+	r := ChaosStudioExperimentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccChaosStudioExperiment_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_chaos_studio_experiment", "test")
+	r := ChaosStudioExperimentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccChaosStudioExperiment_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_chaos_studio_experiment", "test")
+	r := ChaosStudioExperimentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ChaosStudioExperimentResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := experiments.ParseExperimentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ChaosStudio.ExperimentsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r ChaosStudioExperimentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_chaos_studio_experiment" "test" {
+  name                = "acctest-experiment-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  selector {
+    name                    = "selector1"
+    chaos_studio_target_ids = [azurerm_chaos_studio_target.test.id]
+  }
+
+  step {
+    name = "step1"
+
+    branch {
+      name = "branch1"
+
+      action {
+        action_type = "delay"
+        duration    = "PT10M"
+      }
+    }
+  }
+}
+`, ChaosStudioCapabilityResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ChaosStudioExperimentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_chaos_studio_experiment" "import" {
+  name                = azurerm_chaos_studio_experiment.test.name
+  resource_group_name = azurerm_chaos_studio_experiment.test.resource_group_name
+  location            = azurerm_chaos_studio_experiment.test.location
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  selector {
+    name                    = "selector1"
+    chaos_studio_target_ids = [azurerm_chaos_studio_target.test.id]
+  }
+
+  step {
+    name = "step1"
+
+    branch {
+      name = "branch1"
+
+      action {
+        action_type = "delay"
+        duration    = "PT10M"
+      }
+    }
+  }
+}
+`, r.basic(data))
+}
+
+func (r ChaosStudioExperimentResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_chaos_studio_experiment" "test" {
+  name                = "acctest-experiment-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  identity {
+    type = "SystemAssigned"
+  }
+
+  selector {
+    name                    = "selector1"
+    chaos_studio_target_ids = [azurerm_chaos_studio_target.test.id]
+  }
+
+  step {
+    name = "step1"
+
+    branch {
+      name = "branch1"
+
+      action {
+        action_type   = "continuous"
+        urn           = azurerm_chaos_studio_capability.test.urn
+        duration      = "PT5M"
+        selector_name = "selector1"
+        parameters = {
+          jsonSpec = "{\"action\":\"pod-failure\",\"mode\":\"one\",\"selector\":{\"namespaces\":[\"default\"]}}"
+        }
+      }
+    }
+
+    branch {
+      name = "branch2"
+
+      action {
+        action_type = "delay"
+        duration    = "PT1M"
+      }
+    }
+  }
+
+  step {
+    name = "step2"
+
+    branch {
+      name = "branch1"
+
+      action {
+        action_type = "delay"
+        duration    = "PT5M"
+      }
+    }
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_kubernetes_cluster.test.id
+  role_definition_name = "Azure Kubernetes Service Cluster Admin Role"
+  principal_id         = azurerm_chaos_studio_experiment.test.identity.0.principal_id
+}
+`, ChaosStudioCapabilityResource{}.basic(data), data.RandomInteger)
+}
@@ -0,0 +1,175 @@
+package chaosstudio
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/chaosstudio/2022-07-01-preview/targets"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ChaosStudioTargetResource struct{}
+
+var _ sdk.Resource = ChaosStudioTargetResource{}
+
+type ChaosStudioTargetResourceModel struct {
+	TargetResourceId string `tfschema:"target_resource_id"`
+	TargetType       string `tfschema:"target_type"`
+	Location         string `tfschema:"location"`
+}
+
+func (r ChaosStudioTargetResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"target_resource_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: azure.ValidateResourceID,
+		},
+
+		// Example: Microsoft-AzureKubernetesServiceChaosMesh or Microsoft-VirtualMachine
+		"target_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"location": commonschema.Location(),
+	}
+}
+
+func (r ChaosStudioTargetResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ChaosStudioTargetResource) ModelObject() interface{} {
+	return &ChaosStudioTargetResourceModel{}
+}
+
+func (r ChaosStudioTargetResource) ResourceType() string {
+	return "azurerm_chaos_studio_target"
+}
+
+func (r ChaosStudioTargetResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return targets.ValidateTargetID
+}
+
+func (r ChaosStudioTargetResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model ChaosStudioTargetResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.ChaosStudio.TargetsClient
+
+			id, err := chaosStudioTargetIdFromResourceId(model.TargetResourceId, model.TargetType)
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", *id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), *id)
+			}
+
+			payload := targets.Target{
+				Location:   utils.String(location.Normalize(model.Location)),
+				Properties: map[string]interface{}{},
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", *id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r ChaosStudioTargetResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ChaosStudio.TargetsClient
+
+			id, err := targets.ParseTargetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ChaosStudioTargetResourceModel{
+				TargetResourceId: fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/%s/%s/%s", id.SubscriptionId, id.ResourceGroupName, id.ParentProviderNamespace, id.ParentResourceType, id.ParentResourceName),
+				TargetType:       id.TargetName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.NormalizeNilable(model.Location)
+			}
+
+			return metadata.Encode(&state)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r ChaosStudioTargetResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ChaosStudio.TargetsClient
+
+			id, err := targets.ParseTargetID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+// chaosStudioTargetIdFromResourceId builds the ID of the Chaos Studio Target which extends the specified
+// Resource - only top-level Resources within a Resource Group can be onboarded as Targets
+func chaosStudioTargetIdFromResourceId(resourceId string, targetType string) (*targets.TargetId, error) {
+	parsed, err := azure.ParseAzureResourceID(resourceId)
+	if err != nil {
+		return nil, fmt.Errorf("parsing `target_resource_id`: %+v", err)
+	}
+
+	segments := strings.Split(strings.Trim(resourceId, "/"), "/")
+	if len(segments) != 8 || !strings.EqualFold(segments[4], "providers") {
+		return nil, fmt.Errorf("expected `target_resource_id` to be in the format `/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{providerNamespace}/{resourceType}/{resourceName}` but got %q", resourceId)
+	}
+
+	id := targets.NewTargetID(parsed.SubscriptionID, parsed.ResourceGroup, segments[5], segments[6], segments[7], targetType)
+	return &id, nil
+}
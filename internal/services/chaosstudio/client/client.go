@@ -0,0 +1,33 @@
+package client
+
+import (
+	"github.com/hashicorp/go-azure-sdk/resource-manager/chaosstudio/2022-07-01-preview/capabilities"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/chaosstudio/2022-07-01-preview/targets"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/chaosstudio/sdk/2022-07-01-preview/experiments"
+)
+
+type Client struct {
+	CapabilitiesClient *capabilities.CapabilitiesClient
+	ExperimentsClient  *experiments.ExperimentsClient
+	TargetsClient      *targets.TargetsClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	capabilitiesClient := capabilities.NewCapabilitiesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&capabilitiesClient.Client, o.ResourceManagerAuthorizer)
+
+	// NOTE: the Experiments SDK is embedded since the published SDK doesn't expose the
+	// `duration`, `parameters` or `selectorId` fields of the (discriminated) Actions
+	experimentsClient := experiments.NewExperimentsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&experimentsClient.Client, o.ResourceManagerAuthorizer)
+
+	targetsClient := targets.NewTargetsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&targetsClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		CapabilitiesClient: &capabilitiesClient,
+		ExperimentsClient:  &experimentsClient,
+		TargetsClient:      &targetsClient,
+	}
+}
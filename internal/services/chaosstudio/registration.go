@@ -0,0 +1,29 @@
+package chaosstudio
+
+import "github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+
+type Registration struct{}
+
+func (r Registration) WebsiteCategories() []string {
+	return []string{
+		"Chaos Studio",
+	}
+}
+
+func (r Registration) Name() string {
+	return "Chaos Studio"
+}
+
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		ChaosStudioCapabilityResource{},
+		ChaosStudioExperimentResource{},
+		ChaosStudioTargetResource{},
+	}
+}
+
+var _ sdk.TypedServiceRegistration = Registration{}
@@ -0,0 +1,63 @@
+package experiments
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type Action interface {
+}
+
+func unmarshalActionImplementation(input []byte) (Action, error) {
+	if input == nil {
+		return nil, nil
+	}
+
+	var temp map[string]interface{}
+	if err := json.Unmarshal(input, &temp); err != nil {
+		return nil, fmt.Errorf("unmarshaling Action into map[string]interface: %+v", err)
+	}
+
+	value, ok := temp["type"].(string)
+	if !ok {
+		return nil, nil
+	}
+
+	if strings.EqualFold(value, "continuous") {
+		var out ContinuousAction
+		if err := json.Unmarshal(input, &out); err != nil {
+			return nil, fmt.Errorf("unmarshaling into ContinuousAction: %+v", err)
+		}
+		return out, nil
+	}
+
+	if strings.EqualFold(value, "delay") {
+		var out DelayAction
+		if err := json.Unmarshal(input, &out); err != nil {
+			return nil, fmt.Errorf("unmarshaling into DelayAction: %+v", err)
+		}
+		return out, nil
+	}
+
+	if strings.EqualFold(value, "discrete") {
+		var out DiscreteAction
+		if err := json.Unmarshal(input, &out); err != nil {
+			return nil, fmt.Errorf("unmarshaling into DiscreteAction: %+v", err)
+		}
+		return out, nil
+	}
+
+	type RawActionImpl struct {
+		Type   string                 `json:"-"`
+		Values map[string]interface{} `json:"-"`
+	}
+	out := RawActionImpl{
+		Type:   value,
+		Values: temp,
+	}
+	return out, nil
+}
@@ -1,7 +1,8 @@
 package client
 
 import (
-	"github.com/Azure/azure-sdk-for-go/services/marketplaceordering/mgmt/2015-06-01/marketplaceordering" // nolint: staticcheck
+	"github.com/Azure/azure-sdk-for-go/services/marketplaceordering/mgmt/2015-06-01/marketplaceordering"               // nolint: staticcheck
+	"github.com/Azure/azure-sdk-for-go/services/virtualmachineimagebuilder/mgmt/2021-10-01/virtualmachineimagebuilder" // nolint: staticcheck
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2021-07-01/skus"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2021-11-01/availabilitysets"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2021-11-01/dedicatedhostgroups"
@@ -30,9 +31,12 @@ type Client struct {
 	GalleryApplicationVersionsClient *compute.GalleryApplicationVersionsClient
 	GalleryImagesClient              *compute.GalleryImagesClient
 	GalleryImageVersionsClient       *compute.GalleryImageVersionsClient
+	ImageBuilderTemplatesClient      *virtualmachineimagebuilder.VirtualMachineImageTemplatesClient
 	ImagesClient                     *compute.ImagesClient
 	MarketplaceAgreementsClient      *marketplaceordering.MarketplaceAgreementsClient
 	ProximityPlacementGroupsClient   *proximityplacementgroups.ProximityPlacementGroupsClient
+	RestorePointCollectionsClient    *compute.RestorePointCollectionsClient
+	RestorePointsClient              *compute.RestorePointsClient
 	SkusClient                       *skus.SkusClient
 	SSHPublicKeysClient              *sshpublickeys.SshPublicKeysClient
 	SnapshotsClient                  *snapshots.SnapshotsClient
@@ -88,6 +92,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	galleryImageVersionsClient := compute.NewGalleryImageVersionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&galleryImageVersionsClient.Client, o.ResourceManagerAuthorizer)
 
+	imageBuilderTemplatesClient := virtualmachineimagebuilder.NewVirtualMachineImageTemplatesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&imageBuilderTemplatesClient.Client, o.ResourceManagerAuthorizer)
+
 	imagesClient := compute.NewImagesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&imagesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -97,6 +104,12 @@ func NewClient(o *common.ClientOptions) *Client {
 	proximityPlacementGroupsClient := proximityplacementgroups.NewProximityPlacementGroupsClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&proximityPlacementGroupsClient.Client, o.ResourceManagerAuthorizer)
 
+	restorePointCollectionsClient := compute.NewRestorePointCollectionsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&restorePointCollectionsClient.Client, o.ResourceManagerAuthorizer)
+
+	restorePointsClient := compute.NewRestorePointsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&restorePointsClient.Client, o.ResourceManagerAuthorizer)
+
 	skusClient := skus.NewSkusClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&skusClient.Client, o.ResourceManagerAuthorizer)
 
@@ -150,9 +163,12 @@ func NewClient(o *common.ClientOptions) *Client {
 		GalleryApplicationVersionsClient: &galleryApplicationVersionsClient,
 		GalleryImagesClient:              &galleryImagesClient,
 		GalleryImageVersionsClient:       &galleryImageVersionsClient,
+		ImageBuilderTemplatesClient:      &imageBuilderTemplatesClient,
 		ImagesClient:                     &imagesClient,
 		MarketplaceAgreementsClient:      &marketplaceAgreementsClient,
 		ProximityPlacementGroupsClient:   &proximityPlacementGroupsClient,
+		RestorePointCollectionsClient:    &restorePointCollectionsClient,
+		RestorePointsClient:              &restorePointsClient,
 		SkusClient:                       &skusClient,
 		SSHPublicKeysClient:              &sshPublicKeysClient,
 		SnapshotsClient:                  &snapshotsClient,
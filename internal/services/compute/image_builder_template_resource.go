@@ -0,0 +1,1051 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/virtualmachineimagebuilder/mgmt/2021-10-01/virtualmachineimagebuilder" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ImageBuilderTemplateResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = ImageBuilderTemplateResource{}
+	_ sdk.ResourceWithCustomizeDiff = ImageBuilderTemplateResource{}
+)
+
+type ImageBuilderTemplateModel struct {
+	Name                       string                                `tfschema:"name"`
+	ResourceGroupName          string                                `tfschema:"resource_group_name"`
+	Location                   string                                `tfschema:"location"`
+	Identity                   []identity.ModelUserAssigned          `tfschema:"identity"`
+	PlatformImageSource        []ImageBuilderPlatformImageSource     `tfschema:"platform_image_source"`
+	SharedImageVersionSourceId string                                `tfschema:"shared_image_version_source_id"`
+	Customizer                 []ImageBuilderCustomizer              `tfschema:"customizer"`
+	SharedImageDistribution    []ImageBuilderSharedImageDistribution `tfschema:"shared_image_distribution"`
+	BuildTimeoutInMinutes      int64                                 `tfschema:"build_timeout_in_minutes"`
+	VMSize                     string                                `tfschema:"vm_size"`
+	OsDiskSizeGB               int64                                 `tfschema:"os_disk_size_gb"`
+	SubnetId                   string                                `tfschema:"subnet_id"`
+	ProxyVMSize                string                                `tfschema:"proxy_vm_size"`
+	RunOnCreate                bool                                  `tfschema:"run_on_create"`
+	Tags                       map[string]string                     `tfschema:"tags"`
+}
+
+type ImageBuilderPlatformImageSource struct {
+	Publisher     string `tfschema:"publisher"`
+	Offer         string `tfschema:"offer"`
+	Sku           string `tfschema:"sku"`
+	Version       string `tfschema:"version"`
+	PlanName      string `tfschema:"plan_name"`
+	PlanProduct   string `tfschema:"plan_product"`
+	PlanPublisher string `tfschema:"plan_publisher"`
+}
+
+type ImageBuilderCustomizer struct {
+	Type                string   `tfschema:"type"`
+	Name                string   `tfschema:"name"`
+	Inline              []string `tfschema:"inline"`
+	ScriptUri           string   `tfschema:"script_uri"`
+	SourceUri           string   `tfschema:"source_uri"`
+	Destination         string   `tfschema:"destination"`
+	Sha256Checksum      string   `tfschema:"sha256_checksum"`
+	RunElevated         bool     `tfschema:"run_elevated"`
+	RunAsSystem         bool     `tfschema:"run_as_system"`
+	ValidExitCodes      []int    `tfschema:"valid_exit_codes"`
+	RestartCommand      string   `tfschema:"restart_command"`
+	RestartCheckCommand string   `tfschema:"restart_check_command"`
+	RestartTimeout      string   `tfschema:"restart_timeout"`
+}
+
+type ImageBuilderSharedImageDistribution struct {
+	GalleryImageId     string            `tfschema:"gallery_image_id"`
+	RunOutputName      string            `tfschema:"run_output_name"`
+	ReplicationRegions []string          `tfschema:"replication_regions"`
+	ExcludeFromLatest  bool              `tfschema:"exclude_from_latest"`
+	StorageAccountType string            `tfschema:"storage_account_type"`
+	ArtifactTags       map[string]string `tfschema:"artifact_tags"`
+}
+
+func (r ImageBuilderTemplateResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"identity": commonschema.UserAssignedIdentityRequired(),
+
+		"platform_image_source": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			ForceNew:     true,
+			MaxItems:     1,
+			ExactlyOneOf: []string{"platform_image_source", "shared_image_version_source_id"},
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"publisher": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"offer": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"sku": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"version": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"plan_name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						RequiredWith: []string{"platform_image_source.0.plan_product", "platform_image_source.0.plan_publisher"},
+					},
+
+					"plan_product": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						RequiredWith: []string{"platform_image_source.0.plan_name", "platform_image_source.0.plan_publisher"},
+					},
+
+					"plan_publisher": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						RequiredWith: []string{"platform_image_source.0.plan_name", "platform_image_source.0.plan_product"},
+					},
+				},
+			},
+		},
+
+		"shared_image_version_source_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.SharedImageVersionID,
+			ExactlyOneOf: []string{"platform_image_source", "shared_image_version_source_id"},
+		},
+
+		"customizer": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"type": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ForceNew: true,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(virtualmachineimagebuilder.TypeBasicImageTemplateCustomizerTypeFile),
+							string(virtualmachineimagebuilder.TypeBasicImageTemplateCustomizerTypePowerShell),
+							string(virtualmachineimagebuilder.TypeBasicImageTemplateCustomizerTypeShell),
+							string(virtualmachineimagebuilder.TypeBasicImageTemplateCustomizerTypeWindowsRestart),
+						}, false),
+					},
+
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"inline": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"script_uri": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					},
+
+					"source_uri": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					},
+
+					"destination": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"sha256_checksum": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"run_elevated": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						ForceNew: true,
+						Default:  false,
+					},
+
+					"run_as_system": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						ForceNew: true,
+						Default:  false,
+					},
+
+					"valid_exit_codes": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeInt,
+						},
+					},
+
+					"restart_command": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"restart_check_command": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"restart_timeout": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"shared_image_distribution": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"gallery_image_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validate.SharedImageID,
+					},
+
+					"run_output_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"replication_regions": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type:             pluginsdk.TypeString,
+							ValidateFunc:     location.EnhancedValidate,
+							StateFunc:        location.StateFunc,
+							DiffSuppressFunc: location.DiffSuppressFunc,
+						},
+					},
+
+					"exclude_from_latest": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						ForceNew: true,
+						Default:  false,
+					},
+
+					"storage_account_type": {
+						Type:     pluginsdk.TypeString,
+						Optional: true,
+						ForceNew: true,
+						Default:  string(virtualmachineimagebuilder.SharedImageStorageAccountTypeStandardLRS),
+						ValidateFunc: validation.StringInSlice([]string{
+							string(virtualmachineimagebuilder.SharedImageStorageAccountTypeStandardLRS),
+							string(virtualmachineimagebuilder.SharedImageStorageAccountTypeStandardZRS),
+						}, false),
+					},
+
+					"artifact_tags": {
+						Type:     pluginsdk.TypeMap,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+
+		"build_timeout_in_minutes": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntBetween(0, 960),
+		},
+
+		"vm_size": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"os_disk_size_gb": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+
+		"subnet_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: networkValidate.SubnetID,
+		},
+
+		"proxy_vm_size": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			RequiredWith: []string{"subnet_id"},
+		},
+
+		"run_on_create": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  false,
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r ImageBuilderTemplateResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ImageBuilderTemplateResource) ResourceType() string {
+	return "azurerm_image_builder_template"
+}
+
+func (r ImageBuilderTemplateResource) ModelObject() interface{} {
+	return &ImageBuilderTemplateModel{}
+}
+
+func (r ImageBuilderTemplateResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ImageBuilderTemplateID
+}
+
+func (r ImageBuilderTemplateResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			rd := metadata.ResourceDiff
+
+			if !rd.Get("run_on_create").(bool) || (rd.Id() != "" && !rd.HasChanges("run_on_create", "build_timeout_in_minutes")) {
+				return nil
+			}
+
+			// the image build can run for up to `build_timeout_in_minutes`, which can exceed the Create timeout -
+			// so fail at plan time rather than timing out part-way through the build
+			buildTimeout := time.Duration(rd.Get("build_timeout_in_minutes").(int)) * time.Minute
+			if buildTimeout == 0 {
+				buildTimeout = 240 * time.Minute
+			}
+
+			createTimeout := r.Create().Timeout
+			if config := rd.GetRawConfig(); !config.IsNull() && config.IsKnown() && config.Type().HasAttribute("timeouts") {
+				if timeouts := config.GetAttr("timeouts"); !timeouts.IsNull() && timeouts.IsKnown() && timeouts.Type().HasAttribute("create") {
+					if v := timeouts.GetAttr("create"); !v.IsNull() && v.IsKnown() {
+						timeout, err := time.ParseDuration(v.AsString())
+						if err != nil {
+							return fmt.Errorf("parsing the `create` timeout: %+v", err)
+						}
+						createTimeout = timeout
+					}
+				}
+			}
+
+			if createTimeout <= buildTimeout {
+				return fmt.Errorf("the `create` timeout must be greater than `build_timeout_in_minutes` (%d minutes) when `run_on_create` is enabled", int64(buildTimeout.Minutes()))
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ImageBuilderTemplateResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var state ImageBuilderTemplateModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			client := metadata.Client.Compute.ImageBuilderTemplatesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			id := parse.NewImageBuilderTemplateID(subscriptionId, state.ResourceGroupName, state.Name)
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.ImageTemplateName)
+			if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for the presence of existing %s: %+v", id, err)
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			templateIdentity, err := expandImageBuilderTemplateIdentity(state.Identity)
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			customizers, err := expandImageBuilderTemplateCustomizers(state.Customizer)
+			if err != nil {
+				return fmt.Errorf("expanding `customizer`: %+v", err)
+			}
+
+			input := virtualmachineimagebuilder.ImageTemplate{
+				Location: utils.String(location.Normalize(state.Location)),
+				Identity: templateIdentity,
+				ImageTemplateProperties: &virtualmachineimagebuilder.ImageTemplateProperties{
+					Source:                expandImageBuilderTemplateSource(state),
+					Customize:             customizers,
+					Distribute:            expandImageBuilderTemplateSharedImageDistributions(state.SharedImageDistribution),
+					BuildTimeoutInMinutes: utils.Int32(int32(state.BuildTimeoutInMinutes)),
+					VMProfile:             expandImageBuilderTemplateVMProfile(state),
+				},
+				Tags: tags.FromTypedObject(state.Tags),
+			}
+
+			future, err := client.CreateOrUpdate(ctx, input, id.ResourceGroup, id.ImageTemplateName)
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			if state.RunOnCreate {
+				runFuture, err := client.Run(ctx, id.ResourceGroup, id.ImageTemplateName)
+				if err != nil {
+					return fmt.Errorf("running %s: %+v", id, err)
+				}
+
+				if err := runFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+					return fmt.Errorf("waiting for the run of %s to complete: %+v", id, err)
+				}
+
+				resp, err := client.Get(ctx, id.ResourceGroup, id.ImageTemplateName)
+				if err != nil {
+					return fmt.Errorf("retrieving %s: %+v", id, err)
+				}
+
+				if props := resp.ImageTemplateProperties; props != nil && props.LastRunStatus != nil {
+					if runState := props.LastRunStatus.RunState; runState != virtualmachineimagebuilder.RunStateSucceeded {
+						message := ""
+						if props.LastRunStatus.Message != nil {
+							message = *props.LastRunStatus.Message
+						}
+						return fmt.Errorf("the run of %s finished in the state %q: %s", id, string(runState), message)
+					}
+				}
+			}
+
+			return nil
+		},
+		Timeout: 5 * time.Hour,
+	}
+}
+
+func (r ImageBuilderTemplateResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.ImageBuilderTemplatesClient
+			id, err := parse.ImageBuilderTemplateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.ResourceGroup, id.ImageTemplateName)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					metadata.Logger.Infof("%s was not found - removing from state!", *id)
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			templateIdentity, err := flattenImageBuilderTemplateIdentity(resp.Identity)
+			if err != nil {
+				return fmt.Errorf("flattening `identity`: %+v", err)
+			}
+
+			state := ImageBuilderTemplateModel{
+				Name:              id.ImageTemplateName,
+				ResourceGroupName: id.ResourceGroup,
+				Location:          location.NormalizeNilable(resp.Location),
+				Identity:          templateIdentity,
+				// `run_on_create` isn't returned by the API, so we persist the existing value
+				RunOnCreate: metadata.ResourceData.Get("run_on_create").(bool),
+				Tags:        tags.ToTypedObject(resp.Tags),
+			}
+
+			if props := resp.ImageTemplateProperties; props != nil {
+				if source := props.Source; source != nil {
+					if v, ok := source.AsImageTemplatePlatformImageSource(); ok {
+						state.PlatformImageSource = flattenImageBuilderTemplatePlatformImageSource(v)
+					}
+
+					if v, ok := source.AsImageTemplateSharedImageVersionSource(); ok && v.ImageVersionID != nil {
+						sharedImageVersionId, err := parse.SharedImageVersionID(*v.ImageVersionID)
+						if err != nil {
+							return fmt.Errorf("parsing %q: %+v", *v.ImageVersionID, err)
+						}
+						state.SharedImageVersionSourceId = sharedImageVersionId.ID()
+					}
+				}
+
+				state.Customizer = flattenImageBuilderTemplateCustomizers(props.Customize)
+
+				distributions, err := flattenImageBuilderTemplateSharedImageDistributions(props.Distribute)
+				if err != nil {
+					return fmt.Errorf("flattening `shared_image_distribution`: %+v", err)
+				}
+				state.SharedImageDistribution = distributions
+
+				if props.BuildTimeoutInMinutes != nil {
+					state.BuildTimeoutInMinutes = int64(*props.BuildTimeoutInMinutes)
+				}
+
+				if profile := props.VMProfile; profile != nil {
+					if profile.VMSize != nil {
+						state.VMSize = *profile.VMSize
+					}
+
+					if profile.OsDiskSizeGB != nil {
+						state.OsDiskSizeGB = int64(*profile.OsDiskSizeGB)
+					}
+
+					if vnetConfig := profile.VnetConfig; vnetConfig != nil {
+						if vnetConfig.SubnetID != nil {
+							state.SubnetId = *vnetConfig.SubnetID
+						}
+
+						if vnetConfig.ProxyVMSize != nil {
+							state.ProxyVMSize = *vnetConfig.ProxyVMSize
+						}
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r ImageBuilderTemplateResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ImageBuilderTemplateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state ImageBuilderTemplateModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			client := metadata.Client.Compute.ImageBuilderTemplatesClient
+
+			// only the `identity` and `tags` can be updated, all other properties are immutable
+			if !metadata.ResourceData.HasChanges("identity", "tags") {
+				return nil
+			}
+
+			templateIdentity, err := expandImageBuilderTemplateIdentity(state.Identity)
+			if err != nil {
+				return fmt.Errorf("expanding `identity`: %+v", err)
+			}
+
+			input := virtualmachineimagebuilder.ImageTemplateUpdateParameters{
+				Identity: templateIdentity,
+				Tags:     tags.FromTypedObject(state.Tags),
+			}
+
+			future, err := client.Update(ctx, input, id.ResourceGroup, id.ImageTemplateName)
+			if err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for update of %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r ImageBuilderTemplateResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.ImageBuilderTemplatesClient
+			id, err := parse.ImageBuilderTemplateID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			future, err := client.Delete(ctx, id.ResourceGroup, id.ImageTemplateName)
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+		Timeout: 60 * time.Minute,
+	}
+}
+
+func expandImageBuilderTemplateIdentity(input []identity.ModelUserAssigned) (*virtualmachineimagebuilder.ImageTemplateIdentity, error) {
+	expanded, err := identity.ExpandUserAssignedMapFromModel(input)
+	if err != nil {
+		return nil, err
+	}
+
+	out := virtualmachineimagebuilder.ImageTemplateIdentity{
+		Type: virtualmachineimagebuilder.ResourceIdentityTypeNone,
+	}
+
+	if expanded.Type == identity.TypeUserAssigned {
+		out.Type = virtualmachineimagebuilder.ResourceIdentityTypeUserAssigned
+		out.UserAssignedIdentities = make(map[string]*virtualmachineimagebuilder.ImageTemplateIdentityUserAssignedIdentitiesValue)
+		for k := range expanded.IdentityIds {
+			out.UserAssignedIdentities[k] = &virtualmachineimagebuilder.ImageTemplateIdentityUserAssignedIdentitiesValue{}
+		}
+	}
+
+	return &out, nil
+}
+
+func flattenImageBuilderTemplateIdentity(input *virtualmachineimagebuilder.ImageTemplateIdentity) ([]identity.ModelUserAssigned, error) {
+	var transform *identity.UserAssignedMap
+
+	if input != nil {
+		transform = &identity.UserAssignedMap{
+			Type:        identity.Type(string(input.Type)),
+			IdentityIds: make(map[string]identity.UserAssignedIdentityDetails),
+		}
+		for k := range input.UserAssignedIdentities {
+			transform.IdentityIds[k] = identity.UserAssignedIdentityDetails{}
+		}
+	}
+
+	output, err := identity.FlattenUserAssignedMapToModel(transform)
+	if err != nil {
+		return nil, err
+	}
+
+	return *output, nil
+}
+
+func expandImageBuilderTemplateSource(input ImageBuilderTemplateModel) virtualmachineimagebuilder.BasicImageTemplateSource {
+	if input.SharedImageVersionSourceId != "" {
+		return virtualmachineimagebuilder.ImageTemplateSharedImageVersionSource{
+			ImageVersionID: utils.String(input.SharedImageVersionSourceId),
+			Type:           virtualmachineimagebuilder.TypeSharedImageVersion,
+		}
+	}
+
+	if len(input.PlatformImageSource) == 0 {
+		return nil
+	}
+
+	source := input.PlatformImageSource[0]
+	output := virtualmachineimagebuilder.ImageTemplatePlatformImageSource{
+		Publisher: utils.String(source.Publisher),
+		Offer:     utils.String(source.Offer),
+		Sku:       utils.String(source.Sku),
+		Version:   utils.String(source.Version),
+		Type:      virtualmachineimagebuilder.TypePlatformImage,
+	}
+
+	if source.PlanName != "" {
+		output.PlanInfo = &virtualmachineimagebuilder.PlatformImagePurchasePlan{
+			PlanName:      utils.String(source.PlanName),
+			PlanProduct:   utils.String(source.PlanProduct),
+			PlanPublisher: utils.String(source.PlanPublisher),
+		}
+	}
+
+	return output
+}
+
+func flattenImageBuilderTemplatePlatformImageSource(input *virtualmachineimagebuilder.ImageTemplatePlatformImageSource) []ImageBuilderPlatformImageSource {
+	output := ImageBuilderPlatformImageSource{}
+
+	if input.Publisher != nil {
+		output.Publisher = *input.Publisher
+	}
+
+	if input.Offer != nil {
+		output.Offer = *input.Offer
+	}
+
+	if input.Sku != nil {
+		output.Sku = *input.Sku
+	}
+
+	if input.Version != nil {
+		output.Version = *input.Version
+	}
+
+	if plan := input.PlanInfo; plan != nil {
+		if plan.PlanName != nil {
+			output.PlanName = *plan.PlanName
+		}
+
+		if plan.PlanProduct != nil {
+			output.PlanProduct = *plan.PlanProduct
+		}
+
+		if plan.PlanPublisher != nil {
+			output.PlanPublisher = *plan.PlanPublisher
+		}
+	}
+
+	return []ImageBuilderPlatformImageSource{output}
+}
+
+func expandImageBuilderTemplateCustomizers(input []ImageBuilderCustomizer) (*[]virtualmachineimagebuilder.BasicImageTemplateCustomizer, error) {
+	output := make([]virtualmachineimagebuilder.BasicImageTemplateCustomizer, 0)
+
+	for i, v := range input {
+		var name *string
+		if v.Name != "" {
+			name = utils.String(v.Name)
+		}
+
+		switch virtualmachineimagebuilder.TypeBasicImageTemplateCustomizer(v.Type) {
+		case virtualmachineimagebuilder.TypeBasicImageTemplateCustomizerTypeShell:
+			if v.ScriptUri == "" && len(v.Inline) == 0 {
+				return nil, fmt.Errorf("one of `script_uri` or `inline` must be specified for the `Shell` customizer at index %d", i)
+			}
+
+			customizer := virtualmachineimagebuilder.ImageTemplateShellCustomizer{
+				Name: name,
+				Type: virtualmachineimagebuilder.TypeBasicImageTemplateCustomizerTypeShell,
+			}
+			if v.ScriptUri != "" {
+				customizer.ScriptURI = utils.String(v.ScriptUri)
+			}
+			if v.Sha256Checksum != "" {
+				customizer.Sha256Checksum = utils.String(v.Sha256Checksum)
+			}
+			if len(v.Inline) > 0 {
+				customizer.Inline = utils.StringSlice(v.Inline)
+			}
+			output = append(output, customizer)
+
+		case virtualmachineimagebuilder.TypeBasicImageTemplateCustomizerTypePowerShell:
+			if v.ScriptUri == "" && len(v.Inline) == 0 {
+				return nil, fmt.Errorf("one of `script_uri` or `inline` must be specified for the `PowerShell` customizer at index %d", i)
+			}
+
+			customizer := virtualmachineimagebuilder.ImageTemplatePowerShellCustomizer{
+				Name:        name,
+				RunElevated: utils.Bool(v.RunElevated),
+				RunAsSystem: utils.Bool(v.RunAsSystem),
+				Type:        virtualmachineimagebuilder.TypeBasicImageTemplateCustomizerTypePowerShell,
+			}
+			if v.ScriptUri != "" {
+				customizer.ScriptURI = utils.String(v.ScriptUri)
+			}
+			if v.Sha256Checksum != "" {
+				customizer.Sha256Checksum = utils.String(v.Sha256Checksum)
+			}
+			if len(v.Inline) > 0 {
+				customizer.Inline = utils.StringSlice(v.Inline)
+			}
+			if len(v.ValidExitCodes) > 0 {
+				exitCodes := make([]int32, 0)
+				for _, code := range v.ValidExitCodes {
+					exitCodes = append(exitCodes, int32(code))
+				}
+				customizer.ValidExitCodes = &exitCodes
+			}
+			output = append(output, customizer)
+
+		case virtualmachineimagebuilder.TypeBasicImageTemplateCustomizerTypeFile:
+			if v.SourceUri == "" || v.Destination == "" {
+				return nil, fmt.Errorf("`source_uri` and `destination` must be specified for the `File` customizer at index %d", i)
+			}
+
+			customizer := virtualmachineimagebuilder.ImageTemplateFileCustomizer{
+				Name:        name,
+				SourceURI:   utils.String(v.SourceUri),
+				Destination: utils.String(v.Destination),
+				Type:        virtualmachineimagebuilder.TypeBasicImageTemplateCustomizerTypeFile,
+			}
+			if v.Sha256Checksum != "" {
+				customizer.Sha256Checksum = utils.String(v.Sha256Checksum)
+			}
+			output = append(output, customizer)
+
+		case virtualmachineimagebuilder.TypeBasicImageTemplateCustomizerTypeWindowsRestart:
+			customizer := virtualmachineimagebuilder.ImageTemplateRestartCustomizer{
+				Name: name,
+				Type: virtualmachineimagebuilder.TypeBasicImageTemplateCustomizerTypeWindowsRestart,
+			}
+			if v.RestartCommand != "" {
+				customizer.RestartCommand = utils.String(v.RestartCommand)
+			}
+			if v.RestartCheckCommand != "" {
+				customizer.RestartCheckCommand = utils.String(v.RestartCheckCommand)
+			}
+			if v.RestartTimeout != "" {
+				customizer.RestartTimeout = utils.String(v.RestartTimeout)
+			}
+			output = append(output, customizer)
+
+		default:
+			return nil, fmt.Errorf("unsupported customizer type %q at index %d", v.Type, i)
+		}
+	}
+
+	return &output, nil
+}
+
+func flattenImageBuilderTemplateCustomizers(input *[]virtualmachineimagebuilder.BasicImageTemplateCustomizer) []ImageBuilderCustomizer {
+	output := make([]ImageBuilderCustomizer, 0)
+	if input == nil {
+		return output
+	}
+
+	for _, raw := range *input {
+		if v, ok := raw.AsImageTemplateShellCustomizer(); ok {
+			output = append(output, ImageBuilderCustomizer{
+				Type:           string(virtualmachineimagebuilder.TypeBasicImageTemplateCustomizerTypeShell),
+				Name:           utils.NormalizeNilableString(v.Name),
+				Inline:         flattenImageBuilderTemplateInline(v.Inline),
+				ScriptUri:      utils.NormalizeNilableString(v.ScriptURI),
+				Sha256Checksum: utils.NormalizeNilableString(v.Sha256Checksum),
+			})
+			continue
+		}
+
+		if v, ok := raw.AsImageTemplatePowerShellCustomizer(); ok {
+			exitCodes := make([]int, 0)
+			if v.ValidExitCodes != nil {
+				for _, code := range *v.ValidExitCodes {
+					exitCodes = append(exitCodes, int(code))
+				}
+			}
+
+			output = append(output, ImageBuilderCustomizer{
+				Type:           string(virtualmachineimagebuilder.TypeBasicImageTemplateCustomizerTypePowerShell),
+				Name:           utils.NormalizeNilableString(v.Name),
+				Inline:         flattenImageBuilderTemplateInline(v.Inline),
+				ScriptUri:      utils.NormalizeNilableString(v.ScriptURI),
+				Sha256Checksum: utils.NormalizeNilableString(v.Sha256Checksum),
+				RunElevated:    v.RunElevated != nil && *v.RunElevated,
+				RunAsSystem:    v.RunAsSystem != nil && *v.RunAsSystem,
+				ValidExitCodes: exitCodes,
+			})
+			continue
+		}
+
+		if v, ok := raw.AsImageTemplateFileCustomizer(); ok {
+			output = append(output, ImageBuilderCustomizer{
+				Type:           string(virtualmachineimagebuilder.TypeBasicImageTemplateCustomizerTypeFile),
+				Name:           utils.NormalizeNilableString(v.Name),
+				SourceUri:      utils.NormalizeNilableString(v.SourceURI),
+				Destination:    utils.NormalizeNilableString(v.Destination),
+				Sha256Checksum: utils.NormalizeNilableString(v.Sha256Checksum),
+			})
+			continue
+		}
+
+		if v, ok := raw.AsImageTemplateRestartCustomizer(); ok {
+			output = append(output, ImageBuilderCustomizer{
+				Type:                string(virtualmachineimagebuilder.TypeBasicImageTemplateCustomizerTypeWindowsRestart),
+				Name:                utils.NormalizeNilableString(v.Name),
+				RestartCommand:      utils.NormalizeNilableString(v.RestartCommand),
+				RestartCheckCommand: utils.NormalizeNilableString(v.RestartCheckCommand),
+				RestartTimeout:      utils.NormalizeNilableString(v.RestartTimeout),
+			})
+		}
+	}
+
+	return output
+}
+
+func expandImageBuilderTemplateSharedImageDistributions(input []ImageBuilderSharedImageDistribution) *[]virtualmachineimagebuilder.BasicImageTemplateDistributor {
+	output := make([]virtualmachineimagebuilder.BasicImageTemplateDistributor, 0)
+
+	for _, v := range input {
+		replicationRegions := make([]string, 0)
+		for _, region := range v.ReplicationRegions {
+			replicationRegions = append(replicationRegions, location.Normalize(region))
+		}
+
+		output = append(output, virtualmachineimagebuilder.ImageTemplateSharedImageDistributor{
+			GalleryImageID:     utils.String(v.GalleryImageId),
+			RunOutputName:      utils.String(v.RunOutputName),
+			ReplicationRegions: &replicationRegions,
+			ExcludeFromLatest:  utils.Bool(v.ExcludeFromLatest),
+			StorageAccountType: virtualmachineimagebuilder.SharedImageStorageAccountType(v.StorageAccountType),
+			ArtifactTags:       tags.FromTypedObject(v.ArtifactTags),
+			Type:               virtualmachineimagebuilder.TypeBasicImageTemplateDistributorTypeSharedImage,
+		})
+	}
+
+	return &output
+}
+
+func flattenImageBuilderTemplateSharedImageDistributions(input *[]virtualmachineimagebuilder.BasicImageTemplateDistributor) ([]ImageBuilderSharedImageDistribution, error) {
+	output := make([]ImageBuilderSharedImageDistribution, 0)
+	if input == nil {
+		return output, nil
+	}
+
+	for _, raw := range *input {
+		v, ok := raw.AsImageTemplateSharedImageDistributor()
+		if !ok {
+			continue
+		}
+
+		distribution := ImageBuilderSharedImageDistribution{
+			RunOutputName:      utils.NormalizeNilableString(v.RunOutputName),
+			ReplicationRegions: make([]string, 0),
+			ExcludeFromLatest:  v.ExcludeFromLatest != nil && *v.ExcludeFromLatest,
+			StorageAccountType: string(v.StorageAccountType),
+			ArtifactTags:       tags.ToTypedObject(v.ArtifactTags),
+		}
+
+		if v.GalleryImageID != nil {
+			galleryImageId, err := parse.SharedImageID(*v.GalleryImageID)
+			if err != nil {
+				return nil, fmt.Errorf("parsing %q: %+v", *v.GalleryImageID, err)
+			}
+			distribution.GalleryImageId = galleryImageId.ID()
+		}
+
+		if v.ReplicationRegions != nil {
+			for _, region := range *v.ReplicationRegions {
+				distribution.ReplicationRegions = append(distribution.ReplicationRegions, location.Normalize(region))
+			}
+		}
+
+		output = append(output, distribution)
+	}
+
+	return output, nil
+}
+
+func expandImageBuilderTemplateVMProfile(input ImageBuilderTemplateModel) *virtualmachineimagebuilder.ImageTemplateVMProfile {
+	output := virtualmachineimagebuilder.ImageTemplateVMProfile{
+		OsDiskSizeGB: utils.Int32(int32(input.OsDiskSizeGB)),
+	}
+
+	if input.VMSize != "" {
+		output.VMSize = utils.String(input.VMSize)
+	}
+
+	if input.SubnetId != "" {
+		output.VnetConfig = &virtualmachineimagebuilder.VirtualNetworkConfig{
+			SubnetID: utils.String(input.SubnetId),
+		}
+
+		if input.ProxyVMSize != "" {
+			output.VnetConfig.ProxyVMSize = utils.String(input.ProxyVMSize)
+		}
+	}
+
+	return &output
+}
+
+func flattenImageBuilderTemplateInline(input *[]string) []string {
+	if input == nil {
+		return make([]string, 0)
+	}
+
+	return *input
+}
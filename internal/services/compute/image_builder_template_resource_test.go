@@ -0,0 +1,415 @@
+package compute_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type ImageBuilderTemplateResource struct{}
+
+func TestAccImageBuilderTemplate_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_image_builder_template", "test")
+	r := ImageBuilderTemplateResource{}
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("run_on_create"),
+	})
+}
+
+func TestAccImageBuilderTemplate_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_image_builder_template", "test")
+	r := ImageBuilderTemplateResource{}
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccImageBuilderTemplate_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_image_builder_template", "test")
+	r := ImageBuilderTemplateResource{}
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("run_on_create"),
+	})
+}
+
+func TestAccImageBuilderTemplate_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_image_builder_template", "test")
+	r := ImageBuilderTemplateResource{}
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("run_on_create"),
+		{
+			Config: r.basicUpdated(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("run_on_create"),
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("run_on_create"),
+	})
+}
+
+func TestAccImageBuilderTemplate_sharedImageVersionSource(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_image_builder_template", "test")
+	r := ImageBuilderTemplateResource{}
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.sharedImageVersionSource(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("run_on_create"),
+	})
+}
+
+func (r ImageBuilderTemplateResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ImageBuilderTemplateID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Compute.ImageBuilderTemplatesClient.Get(ctx, id.ResourceGroup, id.ImageTemplateName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r ImageBuilderTemplateResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_image_builder_template" "test" {
+  name                = "acctest-ibt-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+
+  platform_image_source {
+    publisher = "Canonical"
+    offer     = "0001-com-ubuntu-server-focal"
+    sku       = "20_04-lts"
+    version   = "latest"
+  }
+
+  customizer {
+    type   = "Shell"
+    name   = "update"
+    inline = ["sudo apt-get update"]
+  }
+
+  shared_image_distribution {
+    gallery_image_id    = azurerm_shared_image.test.id
+    run_output_name     = "acctest-output"
+    replication_regions = [azurerm_resource_group.test.location]
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ImageBuilderTemplateResource) basicUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_user_assigned_identity" "other" {
+  name                = "acctest-uai2-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_role_assignment" "other" {
+  scope                = azurerm_resource_group.test.id
+  role_definition_name = "Contributor"
+  principal_id         = azurerm_user_assigned_identity.other.principal_id
+}
+
+resource "azurerm_image_builder_template" "test" {
+  name                = "acctest-ibt-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.other.id]
+  }
+
+  platform_image_source {
+    publisher = "Canonical"
+    offer     = "0001-com-ubuntu-server-focal"
+    sku       = "20_04-lts"
+    version   = "latest"
+  }
+
+  customizer {
+    type   = "Shell"
+    name   = "update"
+    inline = ["sudo apt-get update"]
+  }
+
+  shared_image_distribution {
+    gallery_image_id    = azurerm_shared_image.test.id
+    run_output_name     = "acctest-output"
+    replication_regions = [azurerm_resource_group.test.location]
+  }
+
+  tags = {
+    ENV = "Test"
+  }
+
+  depends_on = [azurerm_role_assignment.test, azurerm_role_assignment.other]
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger)
+}
+
+func (r ImageBuilderTemplateResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_image_builder_template" "import" {
+  name                = azurerm_image_builder_template.test.name
+  resource_group_name = azurerm_image_builder_template.test.resource_group_name
+  location            = azurerm_image_builder_template.test.location
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+
+  platform_image_source {
+    publisher = "Canonical"
+    offer     = "0001-com-ubuntu-server-focal"
+    sku       = "20_04-lts"
+    version   = "latest"
+  }
+
+  customizer {
+    type   = "Shell"
+    name   = "update"
+    inline = ["sudo apt-get update"]
+  }
+
+  shared_image_distribution {
+    gallery_image_id    = azurerm_shared_image.test.id
+    run_output_name     = "acctest-output"
+    replication_regions = [azurerm_resource_group.test.location]
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.basic(data))
+}
+
+func (r ImageBuilderTemplateResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvn-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.0.2.0/24"]
+
+  enforce_private_link_service_network_policies = true
+}
+
+resource "azurerm_image_builder_template" "test" {
+  name                = "acctest-ibt-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+
+  platform_image_source {
+    publisher = "Canonical"
+    offer     = "0001-com-ubuntu-server-focal"
+    sku       = "20_04-lts"
+    version   = "latest"
+  }
+
+  customizer {
+    type   = "Shell"
+    name   = "update"
+    inline = ["sudo apt-get update", "sudo apt-get upgrade -y"]
+  }
+
+  customizer {
+    type        = "File"
+    name        = "readme"
+    source_uri  = "https://raw.githubusercontent.com/hashicorp/terraform-provider-azurerm/main/README.md"
+    destination = "/tmp/README.md"
+  }
+
+  shared_image_distribution {
+    gallery_image_id     = azurerm_shared_image.test.id
+    run_output_name      = "acctest-output"
+    replication_regions  = [azurerm_resource_group.test.location]
+    exclude_from_latest  = true
+    storage_account_type = "Standard_ZRS"
+
+    artifact_tags = {
+      source = "acctest"
+    }
+  }
+
+  build_timeout_in_minutes = 120
+  vm_size                  = "Standard_D2s_v3"
+  os_disk_size_gb          = 64
+  subnet_id                = azurerm_subnet.test.id
+  proxy_vm_size            = "Standard_A1_v2"
+
+  tags = {
+    ENV = "Test"
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.template(data), data.RandomInteger, data.RandomInteger)
+}
+
+func (r ImageBuilderTemplateResource) sharedImageVersionSource(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctest-uai-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_resource_group.test.id
+  role_definition_name = "Contributor"
+  principal_id         = azurerm_user_assigned_identity.test.principal_id
+}
+
+resource "azurerm_image_builder_template" "test" {
+  name                = "acctest-ibt-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+
+  shared_image_version_source_id = azurerm_shared_image_version.test.id
+
+  customizer {
+    type       = "Shell"
+    name       = "setup"
+    script_uri = "https://raw.githubusercontent.com/Azure/azvmimagebuilder/main/quickquickstarts/customizeScript.sh"
+  }
+
+  shared_image_distribution {
+    gallery_image_id    = azurerm_shared_image.test.id
+    run_output_name     = "acctest-output"
+    replication_regions = [azurerm_resource_group.test.location]
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, SharedImageVersionResource{}.imageVersion(data), data.RandomInteger, data.RandomInteger)
+}
+
+func (r ImageBuilderTemplateResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-ibt-%d"
+  location = "%s"
+}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctest-uai-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_resource_group.test.id
+  role_definition_name = "Contributor"
+  principal_id         = azurerm_user_assigned_identity.test.principal_id
+}
+
+resource "azurerm_shared_image_gallery" "test" {
+  name                = "acctestsig%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_shared_image" "test" {
+  name                = "acctestimg%d"
+  gallery_name        = azurerm_shared_image_gallery.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  os_type             = "Linux"
+
+  identifier {
+    publisher = "AccTesPublisher%d"
+    offer     = "AccTesOffer%d"
+    sku       = "AccTesSku%d"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
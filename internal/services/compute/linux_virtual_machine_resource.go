@@ -752,7 +752,11 @@ func resourceLinuxVirtualMachineRead(d *pluginsdk.ResourceData, meta interface{}
 	d.Set("capacity_reservation_group_id", capacityReservationGroupId)
 
 	if props.ApplicationProfile != nil && props.ApplicationProfile.GalleryApplications != nil {
-		d.Set("gallery_application", flattenVirtualMachineGalleryApplication(props.ApplicationProfile.GalleryApplications))
+		// only the Gallery Applications managed by this resource are tracked, those assigned using the
+		// `azurerm_virtual_machine_gallery_application_assignment` resource are ignored
+		versionIds := galleryApplicationVersionIds(d.Get("gallery_application").([]interface{}), "version_id")
+		galleryApplications := filterGalleryApplications(props.ApplicationProfile.GalleryApplications, versionIds)
+		d.Set("gallery_application", flattenVirtualMachineGalleryApplication(galleryApplications))
 	}
 
 	licenseType := ""
@@ -1079,8 +1083,18 @@ func resourceLinuxVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interface
 
 	if d.HasChange("gallery_application") {
 		shouldUpdate = true
+
+		var existingGalleryApplications *[]compute.VMGalleryApplication
+		if props := existing.VirtualMachineProperties; props != nil && props.ApplicationProfile != nil {
+			existingGalleryApplications = props.ApplicationProfile.GalleryApplications
+		}
+
+		// merge the changes into the existing Gallery Applications so that any assigned using the
+		// `azurerm_virtual_machine_gallery_application_assignment` resource are retained
+		oldRaw, newRaw := d.GetChange("gallery_application")
+		previousVersionIds := galleryApplicationVersionIds(oldRaw.([]interface{}), "version_id")
 		update.ApplicationProfile = &compute.ApplicationProfile{
-			GalleryApplications: expandVirtualMachineGalleryApplication(d.Get("gallery_application").([]interface{})),
+			GalleryApplications: mergeGalleryApplications(existingGalleryApplications, previousVersionIds, expandVirtualMachineGalleryApplication(newRaw.([]interface{}))),
 		}
 	}
 
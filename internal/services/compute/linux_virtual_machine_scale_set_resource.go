@@ -15,6 +15,7 @@ import (
 	azValidate "github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -61,6 +62,9 @@ func resourceLinuxVirtualMachineScaleSetCreate(d *pluginsdk.ResourceData, meta i
 
 	id := parse.NewVirtualMachineScaleSetID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 
+	locks.ByName(id.Name, VirtualMachineScaleSetResourceName)
+	defer locks.UnlockByName(id.Name, VirtualMachineScaleSetResourceName)
+
 	// Upgrading to the 2021-07-01 exposed a new expand parameter to the GET method
 	exists, err := client.Get(ctx, id.ResourceGroup, id.Name, compute.ExpandTypesForGetVMScaleSetsUserData)
 	if err != nil {
@@ -466,6 +470,9 @@ func resourceLinuxVirtualMachineScaleSetUpdate(d *pluginsdk.ResourceData, meta i
 		return err
 	}
 
+	locks.ByName(id.Name, VirtualMachineScaleSetResourceName)
+	defer locks.UnlockByName(id.Name, VirtualMachineScaleSetResourceName)
+
 	updateInstances := false
 
 	// retrieve
@@ -915,10 +922,18 @@ func resourceLinuxVirtualMachineScaleSetRead(d *pluginsdk.ResourceData, meta int
 		d.Set("eviction_policy", string(profile.EvictionPolicy))
 
 		if profile.ApplicationProfile != nil && profile.ApplicationProfile.GalleryApplications != nil {
-			d.Set("gallery_application", flattenVirtualMachineScaleSetGalleryApplication(profile.ApplicationProfile.GalleryApplications))
+			// only the Gallery Applications managed by this resource are tracked, those assigned using the
+			// `azurerm_virtual_machine_scale_set_gallery_application_assignment` resource are ignored
+			versionIds := galleryApplicationVersionIds(d.Get("gallery_application").([]interface{}), "version_id")
+			if !features.FourPointOhBeta() {
+				versionIds = append(versionIds, galleryApplicationVersionIds(d.Get("gallery_applications").([]interface{}), "package_reference_id")...)
+			}
+			galleryApplications := filterGalleryApplications(profile.ApplicationProfile.GalleryApplications, versionIds)
+
+			d.Set("gallery_application", flattenVirtualMachineScaleSetGalleryApplication(galleryApplications))
 
 			if !features.FourPointOhBeta() {
-				d.Set("gallery_applications", flattenVirtualMachineScaleSetGalleryApplications(profile.ApplicationProfile.GalleryApplications))
+				d.Set("gallery_applications", flattenVirtualMachineScaleSetGalleryApplications(galleryApplications))
 			}
 		}
 
@@ -1075,6 +1090,9 @@ func resourceLinuxVirtualMachineScaleSetDelete(d *pluginsdk.ResourceData, meta i
 		return err
 	}
 
+	locks.ByName(id.Name, VirtualMachineScaleSetResourceName)
+	defer locks.UnlockByName(id.Name, VirtualMachineScaleSetResourceName)
+
 	// Upgrading to the 2021-07-01 exposed a new expand parameter to the GET method
 	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, compute.ExpandTypesForGetVMScaleSetsUserData)
 	if err != nil {
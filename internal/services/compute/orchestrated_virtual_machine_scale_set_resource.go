@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
 	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -271,6 +272,9 @@ func resourceOrchestratedVirtualMachineScaleSetCreate(d *pluginsdk.ResourceData,
 	isLegacy := true
 	id := parse.NewVirtualMachineScaleSetID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 
+	locks.ByName(id.Name, VirtualMachineScaleSetResourceName)
+	defer locks.UnlockByName(id.Name, VirtualMachineScaleSetResourceName)
+
 	if d.IsNewResource() {
 		// Upgrading to the 2021-07-01 exposed a new expand parameter to the GET method
 		existing, err := client.Get(ctx, id.ResourceGroup, id.Name, compute.ExpandTypesForGetVMScaleSetsUserData)
@@ -684,6 +688,9 @@ func resourceOrchestratedVirtualMachineScaleSetUpdate(d *pluginsdk.ResourceData,
 		return err
 	}
 
+	locks.ByName(id.Name, VirtualMachineScaleSetResourceName)
+	defer locks.UnlockByName(id.Name, VirtualMachineScaleSetResourceName)
+
 	isLegacy := true
 	updateInstances := false
 	isHotpatchEnabledImage := false
@@ -1302,6 +1309,9 @@ func resourceOrchestratedVirtualMachineScaleSetDelete(d *pluginsdk.ResourceData,
 		return err
 	}
 
+	locks.ByName(id.Name, VirtualMachineScaleSetResourceName)
+	defer locks.UnlockByName(id.Name, VirtualMachineScaleSetResourceName)
+
 	// Upgrading to the 2021-07-01 exposed a new expand parameter in the GET method
 	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, compute.ExpandTypesForGetVMScaleSetsUserData)
 	if err != nil {
@@ -0,0 +1,55 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = VirtualMachineGalleryApplicationAssignmentId{}
+
+type VirtualMachineGalleryApplicationAssignmentId struct {
+	VirtualMachineId            VirtualMachineId
+	GalleryApplicationVersionId GalleryApplicationVersionId
+}
+
+func NewVirtualMachineGalleryApplicationAssignmentID(virtualMachineId VirtualMachineId, galleryApplicationVersionId GalleryApplicationVersionId) VirtualMachineGalleryApplicationAssignmentId {
+	return VirtualMachineGalleryApplicationAssignmentId{
+		VirtualMachineId:            virtualMachineId,
+		GalleryApplicationVersionId: galleryApplicationVersionId,
+	}
+}
+
+func (id VirtualMachineGalleryApplicationAssignmentId) ID() string {
+	return fmt.Sprintf("%s|%s", id.VirtualMachineId.ID(), id.GalleryApplicationVersionId.ID())
+}
+
+func (id VirtualMachineGalleryApplicationAssignmentId) String() string {
+	components := []string{
+		fmt.Sprintf("VirtualMachineId %q", id.VirtualMachineId.ID()),
+		fmt.Sprintf("GalleryApplicationVersionId %q", id.GalleryApplicationVersionId.ID()),
+	}
+	return fmt.Sprintf("Virtual Machine Gallery Application Assignment: (%s)", strings.Join(components, " / "))
+}
+
+// VirtualMachineGalleryApplicationAssignmentID parses a VirtualMachineGalleryApplicationAssignment ID in the format `{VirtualMachineId}|{GalleryApplicationVersionId}` into an VirtualMachineGalleryApplicationAssignmentId struct
+func VirtualMachineGalleryApplicationAssignmentID(input string) (*VirtualMachineGalleryApplicationAssignmentId, error) {
+	splitId := strings.Split(input, "|")
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("expected ID to be in the format {VirtualMachineId}|{GalleryApplicationVersionId} but got %q", input)
+	}
+
+	virtualMachineId, err := VirtualMachineID(splitId[0])
+	if err != nil {
+		return nil, err
+	}
+
+	galleryApplicationVersionId, err := GalleryApplicationVersionID(splitId[1])
+	if err != nil {
+		return nil, err
+	}
+
+	id := NewVirtualMachineGalleryApplicationAssignmentID(*virtualMachineId, *galleryApplicationVersionId)
+	return &id, nil
+}
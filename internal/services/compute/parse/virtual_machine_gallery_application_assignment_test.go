@@ -0,0 +1,99 @@
+package parse
+
+import (
+	"testing"
+)
+
+func TestVirtualMachineGalleryApplicationAssignmentIDFormatter(t *testing.T) {
+	actual := NewVirtualMachineGalleryApplicationAssignmentID(
+		NewVirtualMachineID("12345678-1234-9876-4563-123456789012", "resGroup1", "vm1"),
+		NewGalleryApplicationVersionID("12345678-1234-9876-4563-123456789012", "resGroup1", "gallery1", "galleryApplication1", "galleryApplicationVersion1"),
+	).ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachines/vm1|/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/galleries/gallery1/applications/galleryApplication1/versions/galleryApplicationVersion1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestVirtualMachineGalleryApplicationAssignmentID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *VirtualMachineGalleryApplicationAssignmentId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// invalid segments
+			Input: "hello|world",
+			Error: true,
+		},
+
+		{
+			// missing gallery application version id
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachines/vm1",
+			Error: true,
+		},
+
+		{
+			// missing virtual machine id
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/galleries/gallery1/applications/galleryApplication1/versions/galleryApplicationVersion1",
+			Error: true,
+		},
+
+		{
+			// ids in the wrong order
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/galleries/gallery1/applications/galleryApplication1/versions/galleryApplicationVersion1|/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachines/vm1",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachines/vm1|/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/galleries/gallery1/applications/galleryApplication1/versions/galleryApplicationVersion1",
+			Expected: &VirtualMachineGalleryApplicationAssignmentId{
+				VirtualMachineId: VirtualMachineId{
+					SubscriptionId: "12345678-1234-9876-4563-123456789012",
+					ResourceGroup:  "resGroup1",
+					Name:           "vm1",
+				},
+				GalleryApplicationVersionId: GalleryApplicationVersionId{
+					SubscriptionId:  "12345678-1234-9876-4563-123456789012",
+					ResourceGroup:   "resGroup1",
+					GalleryName:     "gallery1",
+					ApplicationName: "galleryApplication1",
+					VersionName:     "galleryApplicationVersion1",
+				},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := VirtualMachineGalleryApplicationAssignmentID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.ID() != v.Expected.ID() {
+			t.Fatalf("Expected %q but got %q", v.Expected.ID(), actual.ID())
+		}
+		if actual.VirtualMachineId.Name != v.Expected.VirtualMachineId.Name {
+			t.Fatalf("Expected %q but got %q for VirtualMachineId.Name", v.Expected.VirtualMachineId.Name, actual.VirtualMachineId.Name)
+		}
+		if actual.GalleryApplicationVersionId.VersionName != v.Expected.GalleryApplicationVersionId.VersionName {
+			t.Fatalf("Expected %q but got %q for GalleryApplicationVersionId.VersionName", v.Expected.GalleryApplicationVersionId.VersionName, actual.GalleryApplicationVersionId.VersionName)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = VirtualMachineScaleSetGalleryApplicationAssignmentId{}
+
+type VirtualMachineScaleSetGalleryApplicationAssignmentId struct {
+	VirtualMachineScaleSetId    VirtualMachineScaleSetId
+	GalleryApplicationVersionId GalleryApplicationVersionId
+}
+
+func NewVirtualMachineScaleSetGalleryApplicationAssignmentID(virtualMachineScaleSetId VirtualMachineScaleSetId, galleryApplicationVersionId GalleryApplicationVersionId) VirtualMachineScaleSetGalleryApplicationAssignmentId {
+	return VirtualMachineScaleSetGalleryApplicationAssignmentId{
+		VirtualMachineScaleSetId:    virtualMachineScaleSetId,
+		GalleryApplicationVersionId: galleryApplicationVersionId,
+	}
+}
+
+func (id VirtualMachineScaleSetGalleryApplicationAssignmentId) ID() string {
+	return fmt.Sprintf("%s|%s", id.VirtualMachineScaleSetId.ID(), id.GalleryApplicationVersionId.ID())
+}
+
+func (id VirtualMachineScaleSetGalleryApplicationAssignmentId) String() string {
+	components := []string{
+		fmt.Sprintf("VirtualMachineScaleSetId %q", id.VirtualMachineScaleSetId.ID()),
+		fmt.Sprintf("GalleryApplicationVersionId %q", id.GalleryApplicationVersionId.ID()),
+	}
+	return fmt.Sprintf("Virtual Machine Scale Set Gallery Application Assignment: (%s)", strings.Join(components, " / "))
+}
+
+// VirtualMachineScaleSetGalleryApplicationAssignmentID parses a VirtualMachineScaleSetGalleryApplicationAssignment ID in the format `{VirtualMachineScaleSetId}|{GalleryApplicationVersionId}` into an VirtualMachineScaleSetGalleryApplicationAssignmentId struct
+func VirtualMachineScaleSetGalleryApplicationAssignmentID(input string) (*VirtualMachineScaleSetGalleryApplicationAssignmentId, error) {
+	splitId := strings.Split(input, "|")
+	if len(splitId) != 2 {
+		return nil, fmt.Errorf("expected ID to be in the format {VirtualMachineScaleSetId}|{GalleryApplicationVersionId} but got %q", input)
+	}
+
+	virtualMachineScaleSetId, err := VirtualMachineScaleSetID(splitId[0])
+	if err != nil {
+		return nil, err
+	}
+
+	galleryApplicationVersionId, err := GalleryApplicationVersionID(splitId[1])
+	if err != nil {
+		return nil, err
+	}
+
+	id := NewVirtualMachineScaleSetGalleryApplicationAssignmentID(*virtualMachineScaleSetId, *galleryApplicationVersionId)
+	return &id, nil
+}
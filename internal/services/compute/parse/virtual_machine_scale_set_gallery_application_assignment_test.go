@@ -0,0 +1,99 @@
+package parse
+
+import (
+	"testing"
+)
+
+func TestVirtualMachineScaleSetGalleryApplicationAssignmentIDFormatter(t *testing.T) {
+	actual := NewVirtualMachineScaleSetGalleryApplicationAssignmentID(
+		NewVirtualMachineScaleSetID("12345678-1234-9876-4563-123456789012", "resGroup1", "scaleSet1"),
+		NewGalleryApplicationVersionID("12345678-1234-9876-4563-123456789012", "resGroup1", "gallery1", "galleryApplication1", "galleryApplicationVersion1"),
+	).ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachineScaleSets/scaleSet1|/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/galleries/gallery1/applications/galleryApplication1/versions/galleryApplicationVersion1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestVirtualMachineScaleSetGalleryApplicationAssignmentID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *VirtualMachineScaleSetGalleryApplicationAssignmentId
+	}{
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// invalid segments
+			Input: "hello|world",
+			Error: true,
+		},
+
+		{
+			// missing gallery application version id
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachineScaleSets/scaleSet1",
+			Error: true,
+		},
+
+		{
+			// missing virtual machine scale set id
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/galleries/gallery1/applications/galleryApplication1/versions/galleryApplicationVersion1",
+			Error: true,
+		},
+
+		{
+			// ids in the wrong order
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/galleries/gallery1/applications/galleryApplication1/versions/galleryApplicationVersion1|/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachineScaleSets/scaleSet1",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/virtualMachineScaleSets/scaleSet1|/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/resGroup1/providers/Microsoft.Compute/galleries/gallery1/applications/galleryApplication1/versions/galleryApplicationVersion1",
+			Expected: &VirtualMachineScaleSetGalleryApplicationAssignmentId{
+				VirtualMachineScaleSetId: VirtualMachineScaleSetId{
+					SubscriptionId: "12345678-1234-9876-4563-123456789012",
+					ResourceGroup:  "resGroup1",
+					Name:           "scaleSet1",
+				},
+				GalleryApplicationVersionId: GalleryApplicationVersionId{
+					SubscriptionId:  "12345678-1234-9876-4563-123456789012",
+					ResourceGroup:   "resGroup1",
+					GalleryName:     "gallery1",
+					ApplicationName: "galleryApplication1",
+					VersionName:     "galleryApplicationVersion1",
+				},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := VirtualMachineScaleSetGalleryApplicationAssignmentID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.ID() != v.Expected.ID() {
+			t.Fatalf("Expected %q but got %q", v.Expected.ID(), actual.ID())
+		}
+		if actual.VirtualMachineScaleSetId.Name != v.Expected.VirtualMachineScaleSetId.Name {
+			t.Fatalf("Expected %q but got %q for VirtualMachineScaleSetId.Name", v.Expected.VirtualMachineScaleSetId.Name, actual.VirtualMachineScaleSetId.Name)
+		}
+		if actual.GalleryApplicationVersionId.VersionName != v.Expected.GalleryApplicationVersionId.VersionName {
+			t.Fatalf("Expected %q but got %q for GalleryApplicationVersionId.VersionName", v.Expected.GalleryApplicationVersionId.VersionName, actual.GalleryApplicationVersionId.VersionName)
+		}
+	}
+}
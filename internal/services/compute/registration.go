@@ -78,12 +78,19 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 }
 
 func (r Registration) DataSources() []sdk.DataSource {
-	return []sdk.DataSource{}
+	return []sdk.DataSource{
+		RestorePointsDataSource{},
+	}
 }
 
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		GalleryApplicationResource{},
 		GalleryApplicationVersionResource{},
+		ImageBuilderTemplateResource{},
+		RestorePointCollectionResource{},
+		RestorePointResource{},
+		VirtualMachineGalleryApplicationAssignmentResource{},
+		VirtualMachineScaleSetGalleryApplicationAssignmentResource{},
 	}
 }
@@ -0,0 +1,243 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-02/disks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/kermit/sdk/compute/2022-08-01/compute"
+)
+
+type RestorePointResource struct{}
+
+var _ sdk.Resource = RestorePointResource{}
+
+type RestorePointModel struct {
+	Name                     string   `tfschema:"name"`
+	RestorePointCollectionId string   `tfschema:"restore_point_collection_id"`
+	ConsistencyMode          string   `tfschema:"consistency_mode"`
+	ExcludedDiskIds          []string `tfschema:"excluded_disk_ids"`
+	CreationTime             string   `tfschema:"creation_time"`
+}
+
+func (r RestorePointResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.RestorePointName(),
+		},
+
+		"restore_point_collection_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.RestorePointCollectionID,
+		},
+
+		"consistency_mode": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(compute.ConsistencyModeTypesApplicationConsistent),
+				string(compute.ConsistencyModeTypesCrashConsistent),
+				string(compute.ConsistencyModeTypesFileSystemConsistent),
+			}, false),
+		},
+
+		"excluded_disk_ids": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: disks.ValidateDiskID,
+			},
+		},
+	}
+}
+
+func (r RestorePointResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"creation_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r RestorePointResource) ResourceType() string {
+	return "azurerm_restore_point"
+}
+
+func (r RestorePointResource) ModelObject() interface{} {
+	return &RestorePointModel{}
+}
+
+func (r RestorePointResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.RestorePointID
+}
+
+func (r RestorePointResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var state RestorePointModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			client := metadata.Client.Compute.RestorePointsClient
+
+			collectionId, err := parse.RestorePointCollectionID(state.RestorePointCollectionId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewRestorePointID(collectionId.SubscriptionId, collectionId.ResourceGroup, collectionId.Name, state.Name)
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.RestorePointCollectionName, id.Name, "")
+			if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for the presence of existing %s: %+v", id, err)
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			input := compute.RestorePoint{
+				RestorePointProperties: &compute.RestorePointProperties{
+					ExcludeDisks: expandRestorePointExcludedDisks(state.ExcludedDiskIds),
+				},
+			}
+
+			if state.ConsistencyMode != "" {
+				input.RestorePointProperties.ConsistencyMode = compute.ConsistencyModeTypes(state.ConsistencyMode)
+			}
+
+			future, err := client.Create(ctx, id.ResourceGroup, id.RestorePointCollectionName, id.Name, input)
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+		Timeout: 60 * time.Minute,
+	}
+}
+
+func (r RestorePointResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.RestorePointsClient
+			id, err := parse.RestorePointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.ResourceGroup, id.RestorePointCollectionName, id.Name, "")
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					metadata.Logger.Infof("%s was not found - removing from state!", *id)
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			collectionId := parse.NewRestorePointCollectionID(id.SubscriptionId, id.ResourceGroup, id.RestorePointCollectionName)
+
+			state := RestorePointModel{
+				Name:                     id.Name,
+				RestorePointCollectionId: collectionId.ID(),
+			}
+
+			if props := resp.RestorePointProperties; props != nil {
+				state.ConsistencyMode = string(props.ConsistencyMode)
+				state.ExcludedDiskIds = flattenRestorePointExcludedDisks(props.ExcludeDisks)
+
+				if v := props.TimeCreated; v != nil {
+					state.CreationTime = v.Format(time.RFC3339)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r RestorePointResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.RestorePointsClient
+			id, err := parse.RestorePointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			future, err := client.Delete(ctx, id.ResourceGroup, id.RestorePointCollectionName, id.Name)
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+		Timeout: 60 * time.Minute,
+	}
+}
+
+func expandRestorePointExcludedDisks(input []string) *[]compute.APIEntityReference {
+	if len(input) == 0 {
+		return nil
+	}
+
+	result := make([]compute.APIEntityReference, 0)
+	for _, v := range input {
+		result = append(result, compute.APIEntityReference{
+			ID: utils.String(v),
+		})
+	}
+
+	return &result
+}
+
+func flattenRestorePointExcludedDisks(input *[]compute.APIEntityReference) []string {
+	result := make([]string, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, v := range *input {
+		if v.ID == nil {
+			continue
+		}
+
+		// normalize the casing of the Disk IDs returned from the API to match the configuration
+		diskId, err := disks.ParseDiskIDInsensitively(*v.ID)
+		if err != nil {
+			result = append(result, *v.ID)
+			continue
+		}
+		result = append(result, diskId.ID())
+	}
+
+	return result
+}
@@ -0,0 +1,132 @@
+package compute_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type RestorePointResource struct{}
+
+func TestAccRestorePoint_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_restore_point", "test")
+	r := RestorePointResource{}
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("creation_time").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccRestorePoint_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_restore_point", "test")
+	r := RestorePointResource{}
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccRestorePoint_excludedDisks(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_restore_point", "test")
+	r := RestorePointResource{}
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.excludedDisks(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("consistency_mode").HasValue("CrashConsistent"),
+				check.That(data.ResourceName).Key("excluded_disk_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r RestorePointResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.RestorePointID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Compute.RestorePointsClient.Get(ctx, id.ResourceGroup, id.RestorePointCollectionName, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (r RestorePointResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_restore_point" "test" {
+  name                        = "acctest-rp-%d"
+  restore_point_collection_id = azurerm_restore_point_collection.test.id
+}
+`, RestorePointCollectionResource{}.basic(data), data.RandomInteger)
+}
+
+func (r RestorePointResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_restore_point" "import" {
+  name                        = azurerm_restore_point.test.name
+  restore_point_collection_id = azurerm_restore_point.test.restore_point_collection_id
+}
+`, r.basic(data))
+}
+
+func (r RestorePointResource) excludedDisks(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_managed_disk" "test" {
+  name                 = "acctestd-%[2]d"
+  location             = azurerm_resource_group.test.location
+  resource_group_name  = azurerm_resource_group.test.name
+  storage_account_type = "Standard_LRS"
+  create_option        = "Empty"
+  disk_size_gb         = 10
+}
+
+resource "azurerm_virtual_machine_data_disk_attachment" "test" {
+  managed_disk_id    = azurerm_managed_disk.test.id
+  virtual_machine_id = azurerm_linux_virtual_machine.test.id
+  lun                = "10"
+  caching            = "ReadWrite"
+}
+
+resource "azurerm_restore_point" "test" {
+  name                        = "acctest-rp-%[2]d"
+  restore_point_collection_id = azurerm_restore_point_collection.test.id
+  consistency_mode            = "CrashConsistent"
+  excluded_disk_ids           = [azurerm_managed_disk.test.id]
+
+  depends_on = [azurerm_virtual_machine_data_disk_attachment.test]
+}
+`, RestorePointCollectionResource{}.basic(data), data.RandomInteger)
+}
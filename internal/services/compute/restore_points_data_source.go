@@ -0,0 +1,192 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/kermit/sdk/compute/2022-08-01/compute"
+)
+
+type RestorePointsDataSource struct{}
+
+var _ sdk.DataSource = RestorePointsDataSource{}
+
+type RestorePointsDataSourceModel struct {
+	RestorePointCollectionName string                        `tfschema:"restore_point_collection_name"`
+	ResourceGroupName          string                        `tfschema:"resource_group_name"`
+	Location                   string                        `tfschema:"location"`
+	SourceVirtualMachineId     string                        `tfschema:"source_virtual_machine_id"`
+	RestorePoints              []RestorePointDataSourceModel `tfschema:"restore_points"`
+	Tags                       map[string]string             `tfschema:"tags"`
+}
+
+type RestorePointDataSourceModel struct {
+	Id                string   `tfschema:"id"`
+	Name              string   `tfschema:"name"`
+	ConsistencyMode   string   `tfschema:"consistency_mode"`
+	CreationTime      string   `tfschema:"creation_time"`
+	ExcludedDiskIds   []string `tfschema:"excluded_disk_ids"`
+	ProvisioningState string   `tfschema:"provisioning_state"`
+}
+
+func (d RestorePointsDataSource) ModelObject() interface{} {
+	return &RestorePointsDataSourceModel{}
+}
+
+func (d RestorePointsDataSource) ResourceType() string {
+	return "azurerm_restore_points"
+}
+
+func (d RestorePointsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"restore_point_collection_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.RestorePointCollectionName(),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupNameForDataSource(),
+	}
+}
+
+func (d RestorePointsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"location": commonschema.LocationComputed(),
+
+		"source_virtual_machine_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"restore_points": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"consistency_mode": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"creation_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"excluded_disk_ids": {
+						Type:     pluginsdk.TypeList,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"provisioning_state": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"tags": tags.SchemaDataSource(),
+	}
+}
+
+func (d RestorePointsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.RestorePointCollectionsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var state RestorePointsDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			id := parse.NewRestorePointCollectionID(subscriptionId, state.ResourceGroupName, state.RestorePointCollectionName)
+
+			resp, err := client.Get(ctx, id.ResourceGroup, id.Name, compute.RestorePointCollectionExpandOptionsRestorePoints)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return fmt.Errorf("%s was not found", id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state.Location = location.NormalizeNilable(resp.Location)
+			state.Tags = tags.ToTypedObject(resp.Tags)
+			state.RestorePoints = make([]RestorePointDataSourceModel, 0)
+
+			if props := resp.RestorePointCollectionProperties; props != nil {
+				if source := props.Source; source != nil && source.ID != nil {
+					state.SourceVirtualMachineId = *source.ID
+				}
+
+				state.RestorePoints = flattenRestorePointsForDataSource(props.RestorePoints)
+			}
+
+			metadata.SetID(id)
+			return metadata.Encode(&state)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func flattenRestorePointsForDataSource(input *[]compute.RestorePoint) []RestorePointDataSourceModel {
+	result := make([]RestorePointDataSourceModel, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, item := range *input {
+		restorePoint := RestorePointDataSourceModel{
+			ExcludedDiskIds: make([]string, 0),
+		}
+
+		if item.ID != nil {
+			restorePoint.Id = *item.ID
+		}
+
+		if item.Name != nil {
+			restorePoint.Name = *item.Name
+		}
+
+		if props := item.RestorePointProperties; props != nil {
+			restorePoint.ConsistencyMode = string(props.ConsistencyMode)
+			restorePoint.ExcludedDiskIds = flattenRestorePointExcludedDisks(props.ExcludeDisks)
+
+			if props.TimeCreated != nil {
+				restorePoint.CreationTime = props.TimeCreated.Format(time.RFC3339)
+			}
+
+			if props.ProvisioningState != nil {
+				restorePoint.ProvisioningState = *props.ProvisioningState
+			}
+		}
+
+		result = append(result, restorePoint)
+	}
+
+	return result
+}
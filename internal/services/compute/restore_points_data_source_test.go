@@ -0,0 +1,41 @@
+package compute_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type RestorePointsDataSource struct{}
+
+func TestAccRestorePointsDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_restore_points", "test")
+	d := RestorePointsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("source_virtual_machine_id").Exists(),
+				check.That(data.ResourceName).Key("restore_points.#").HasValue("1"),
+				check.That(data.ResourceName).Key("restore_points.0.name").Exists(),
+				check.That(data.ResourceName).Key("restore_points.0.creation_time").Exists(),
+			),
+		},
+	})
+}
+
+func (d RestorePointsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_restore_points" "test" {
+  restore_point_collection_name = azurerm_restore_point_collection.test.name
+  resource_group_name           = azurerm_restore_point_collection.test.resource_group_name
+
+  depends_on = [azurerm_restore_point.test]
+}
+`, RestorePointResource{}.basic(data))
+}
@@ -0,0 +1,71 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRestorePointName(t *testing.T) {
+	cases := []struct {
+		Value string
+		Valid bool
+	}{
+		{
+			Value: "",
+			Valid: false,
+		},
+		{
+			Value: "a",
+			Valid: true,
+		},
+		{
+			Value: "restorepoint1",
+			Valid: true,
+		},
+		{
+			Value: "restore-point.1_",
+			Valid: true,
+		},
+		{
+			Value: "_restorepoint",
+			Valid: false,
+		},
+		{
+			Value: "restorepoint-",
+			Valid: false,
+		},
+		{
+			Value: "restorepoint.",
+			Valid: false,
+		},
+		{
+			Value: "restore point",
+			Valid: false,
+		},
+		{
+			Value: strings.Repeat("a", 80),
+			Valid: true,
+		},
+		{
+			Value: strings.Repeat("a", 81),
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Value)
+		_, errors := RestorePointName()(tc.Value, "name")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+
+		_, errors = RestorePointCollectionName()(tc.Value, "name")
+		valid = len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t for Restore Point Collection Name", tc.Valid, valid)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package compute
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
@@ -589,3 +590,80 @@ func flattenVirtualMachineGalleryApplication(input *[]compute.VMGalleryApplicati
 
 	return out
 }
+
+// galleryApplicationVersionIds returns the Gallery Application Version IDs referenced within the
+// `gallery_application` block, where `key` is the name of the field containing the ID
+func galleryApplicationVersionIds(input []interface{}, key string) []string {
+	out := make([]string, 0)
+	for _, raw := range input {
+		v, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		out = append(out, v[key].(string))
+	}
+
+	return out
+}
+
+// filterGalleryApplications returns only the Gallery Applications matching one of the specified Version IDs,
+// which allows Gallery Applications assigned using a Gallery Application Assignment resource to be ignored
+func filterGalleryApplications(input *[]compute.VMGalleryApplication, versionIds []string) *[]compute.VMGalleryApplication {
+	out := make([]compute.VMGalleryApplication, 0)
+	if input == nil {
+		return &out
+	}
+
+	for _, v := range *input {
+		if v.PackageReferenceID != nil && galleryApplicationVersionIdInSlice(*v.PackageReferenceID, versionIds) {
+			out = append(out, v)
+		}
+	}
+
+	return &out
+}
+
+// mergeGalleryApplications replaces the Gallery Applications which were previously managed by the
+// `gallery_application` block with those now defined, retaining any Gallery Applications assigned elsewhere
+func mergeGalleryApplications(existing *[]compute.VMGalleryApplication, previousVersionIds []string, updated *[]compute.VMGalleryApplication) *[]compute.VMGalleryApplication {
+	out := make([]compute.VMGalleryApplication, 0)
+
+	updatedVersionIds := make([]string, 0)
+	if updated != nil {
+		for _, v := range *updated {
+			if v.PackageReferenceID != nil {
+				updatedVersionIds = append(updatedVersionIds, *v.PackageReferenceID)
+			}
+		}
+	}
+
+	if existing != nil {
+		for _, v := range *existing {
+			if v.PackageReferenceID == nil {
+				continue
+			}
+
+			if galleryApplicationVersionIdInSlice(*v.PackageReferenceID, previousVersionIds) || galleryApplicationVersionIdInSlice(*v.PackageReferenceID, updatedVersionIds) {
+				continue
+			}
+
+			out = append(out, v)
+		}
+	}
+
+	if updated != nil {
+		out = append(out, *updated...)
+	}
+
+	return &out
+}
+
+func galleryApplicationVersionIdInSlice(versionId string, versionIds []string) bool {
+	for _, v := range versionIds {
+		if strings.EqualFold(v, versionId) {
+			return true
+		}
+	}
+
+	return false
+}
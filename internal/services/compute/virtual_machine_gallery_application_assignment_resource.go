@@ -0,0 +1,300 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/kermit/sdk/compute/2022-08-01/compute"
+)
+
+type VirtualMachineGalleryApplicationAssignmentResource struct{}
+
+var _ sdk.Resource = VirtualMachineGalleryApplicationAssignmentResource{}
+
+type VirtualMachineGalleryApplicationAssignmentModel struct {
+	GalleryApplicationVersionId string `tfschema:"gallery_application_version_id"`
+	VirtualMachineId            string `tfschema:"virtual_machine_id"`
+	ConfigurationBlobUri        string `tfschema:"configuration_blob_uri"`
+	Order                       int64  `tfschema:"order"`
+	Tag                         string `tfschema:"tag"`
+}
+
+func (r VirtualMachineGalleryApplicationAssignmentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"gallery_application_version_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.GalleryApplicationVersionID,
+		},
+
+		"virtual_machine_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.VirtualMachineID,
+		},
+
+		// Example: https://mystorageaccount.blob.core.windows.net/configurations/settings.config
+		"configuration_blob_uri": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		},
+
+		"order": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      0,
+			ValidateFunc: validation.IntBetween(0, 2147483647),
+		},
+
+		// NOTE: Per the service team, "this is a pass through value that we just add to the model but don't depend on. It can be any string."
+		"tag": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r VirtualMachineGalleryApplicationAssignmentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r VirtualMachineGalleryApplicationAssignmentResource) ResourceType() string {
+	return "azurerm_virtual_machine_gallery_application_assignment"
+}
+
+func (r VirtualMachineGalleryApplicationAssignmentResource) ModelObject() interface{} {
+	return &VirtualMachineGalleryApplicationAssignmentModel{}
+}
+
+func (r VirtualMachineGalleryApplicationAssignmentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.VirtualMachineGalleryApplicationAssignmentID
+}
+
+func (r VirtualMachineGalleryApplicationAssignmentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var state VirtualMachineGalleryApplicationAssignmentModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			client := metadata.Client.Compute.VMClient
+
+			virtualMachineId, err := parse.VirtualMachineID(state.VirtualMachineId)
+			if err != nil {
+				return err
+			}
+
+			versionId, err := parse.GalleryApplicationVersionID(state.GalleryApplicationVersionId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewVirtualMachineGalleryApplicationAssignmentID(*virtualMachineId, *versionId)
+
+			locks.ByName(virtualMachineId.Name, VirtualMachineResourceName)
+			defer locks.UnlockByName(virtualMachineId.Name, VirtualMachineResourceName)
+
+			existing, err := client.Get(ctx, virtualMachineId.ResourceGroup, virtualMachineId.Name, "")
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *virtualMachineId, err)
+			}
+
+			galleryApplications := make([]compute.VMGalleryApplication, 0)
+			if props := existing.VirtualMachineProperties; props != nil && props.ApplicationProfile != nil && props.ApplicationProfile.GalleryApplications != nil {
+				galleryApplications = *props.ApplicationProfile.GalleryApplications
+			}
+
+			for _, v := range galleryApplications {
+				if v.PackageReferenceID != nil && strings.EqualFold(*v.PackageReferenceID, versionId.ID()) {
+					return metadata.ResourceRequiresImport(r.ResourceType(), id)
+				}
+			}
+
+			galleryApplications = append(galleryApplications, expandGalleryApplicationAssignment(versionId.ID(), state.ConfigurationBlobUri, state.Order, state.Tag))
+
+			input := compute.VirtualMachineUpdate{
+				VirtualMachineProperties: &compute.VirtualMachineProperties{
+					ApplicationProfile: &compute.ApplicationProfile{
+						GalleryApplications: &galleryApplications,
+					},
+				},
+			}
+
+			future, err := client.Update(ctx, virtualMachineId.ResourceGroup, virtualMachineId.Name, input)
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+		Timeout: 45 * time.Minute,
+	}
+}
+
+func (r VirtualMachineGalleryApplicationAssignmentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VMClient
+
+			id, err := parse.VirtualMachineGalleryApplicationAssignmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.VirtualMachineId.ResourceGroup, id.VirtualMachineId.Name, "")
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					metadata.Logger.Infof("%s was not found - removing from state!", id.VirtualMachineId)
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", id.VirtualMachineId, err)
+			}
+
+			var galleryApplications *[]compute.VMGalleryApplication
+			if props := resp.VirtualMachineProperties; props != nil && props.ApplicationProfile != nil {
+				galleryApplications = props.ApplicationProfile.GalleryApplications
+			}
+
+			galleryApplication := findGalleryApplicationAssignment(galleryApplications, id.GalleryApplicationVersionId.ID())
+			if galleryApplication == nil {
+				metadata.Logger.Infof("%s was not found - removing from state!", *id)
+				return metadata.MarkAsGone(id)
+			}
+
+			state := VirtualMachineGalleryApplicationAssignmentModel{
+				GalleryApplicationVersionId: id.GalleryApplicationVersionId.ID(),
+				VirtualMachineId:            id.VirtualMachineId.ID(),
+				ConfigurationBlobUri:        utils.NormalizeNilableString(galleryApplication.ConfigurationReference),
+				Tag:                         utils.NormalizeNilableString(galleryApplication.Tags),
+			}
+
+			if galleryApplication.Order != nil {
+				state.Order = int64(*galleryApplication.Order)
+			}
+
+			return metadata.Encode(&state)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r VirtualMachineGalleryApplicationAssignmentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VMClient
+
+			id, err := parse.VirtualMachineGalleryApplicationAssignmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.VirtualMachineId.Name, VirtualMachineResourceName)
+			defer locks.UnlockByName(id.VirtualMachineId.Name, VirtualMachineResourceName)
+
+			existing, err := client.Get(ctx, id.VirtualMachineId.ResourceGroup, id.VirtualMachineId.Name, "")
+			if err != nil {
+				if utils.ResponseWasNotFound(existing.Response) {
+					return nil
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", id.VirtualMachineId, err)
+			}
+
+			var galleryApplications *[]compute.VMGalleryApplication
+			if props := existing.VirtualMachineProperties; props != nil && props.ApplicationProfile != nil {
+				galleryApplications = props.ApplicationProfile.GalleryApplications
+			}
+
+			input := compute.VirtualMachineUpdate{
+				VirtualMachineProperties: &compute.VirtualMachineProperties{
+					ApplicationProfile: &compute.ApplicationProfile{
+						GalleryApplications: removeGalleryApplicationAssignment(galleryApplications, id.GalleryApplicationVersionId.ID()),
+					},
+				},
+			}
+
+			future, err := client.Update(ctx, id.VirtualMachineId.ResourceGroup, id.VirtualMachineId.Name, input)
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for deletion of %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+		Timeout: 45 * time.Minute,
+	}
+}
+
+func expandGalleryApplicationAssignment(versionId string, configurationBlobUri string, order int64, tag string) compute.VMGalleryApplication {
+	output := compute.VMGalleryApplication{
+		PackageReferenceID: utils.String(versionId),
+		Order:              utils.Int32(int32(order)),
+	}
+
+	if configurationBlobUri != "" {
+		output.ConfigurationReference = utils.String(configurationBlobUri)
+	}
+
+	if tag != "" {
+		output.Tags = utils.String(tag)
+	}
+
+	return output
+}
+
+func findGalleryApplicationAssignment(input *[]compute.VMGalleryApplication, versionId string) *compute.VMGalleryApplication {
+	if input == nil {
+		return nil
+	}
+
+	for _, v := range *input {
+		if v.PackageReferenceID != nil && strings.EqualFold(*v.PackageReferenceID, versionId) {
+			return &v
+		}
+	}
+
+	return nil
+}
+
+func removeGalleryApplicationAssignment(input *[]compute.VMGalleryApplication, versionId string) *[]compute.VMGalleryApplication {
+	output := make([]compute.VMGalleryApplication, 0)
+	if input == nil {
+		return &output
+	}
+
+	for _, v := range *input {
+		if v.PackageReferenceID != nil && strings.EqualFold(*v.PackageReferenceID, versionId) {
+			continue
+		}
+
+		output = append(output, v)
+	}
+
+	return &output
+}
@@ -0,0 +1,146 @@
+package compute_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type VirtualMachineGalleryApplicationAssignmentResource struct{}
+
+func TestAccVirtualMachineGalleryApplicationAssignment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_gallery_application_assignment", "test")
+	r := VirtualMachineGalleryApplicationAssignmentResource{}
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualMachineGalleryApplicationAssignment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_gallery_application_assignment", "test")
+	r := VirtualMachineGalleryApplicationAssignmentResource{}
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccVirtualMachineGalleryApplicationAssignment_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_gallery_application_assignment", "test")
+	r := VirtualMachineGalleryApplicationAssignmentResource{}
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualMachineGalleryApplicationAssignment_withVirtualMachineGalleryApplication(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_gallery_application_assignment", "test")
+	r := VirtualMachineGalleryApplicationAssignmentResource{}
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.withVirtualMachineGalleryApplication(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_linux_virtual_machine.test").Key("gallery_application.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r VirtualMachineGalleryApplicationAssignmentResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.VirtualMachineGalleryApplicationAssignmentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Compute.VMClient.Get(ctx, id.VirtualMachineId.ResourceGroup, id.VirtualMachineId.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id.VirtualMachineId, err)
+	}
+
+	if props := resp.VirtualMachineProperties; props != nil && props.ApplicationProfile != nil && props.ApplicationProfile.GalleryApplications != nil {
+		for _, v := range *props.ApplicationProfile.GalleryApplications {
+			if v.PackageReferenceID != nil && *v.PackageReferenceID == id.GalleryApplicationVersionId.ID() {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (r VirtualMachineGalleryApplicationAssignmentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_gallery_application_assignment" "test" {
+  gallery_application_version_id = azurerm_gallery_application_version.test.id
+  virtual_machine_id             = azurerm_linux_virtual_machine.test.id
+}
+`, LinuxVirtualMachineResource{}.otherGalleryApplicationRemoved(data))
+}
+
+func (r VirtualMachineGalleryApplicationAssignmentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_gallery_application_assignment" "import" {
+  gallery_application_version_id = azurerm_virtual_machine_gallery_application_assignment.test.gallery_application_version_id
+  virtual_machine_id             = azurerm_virtual_machine_gallery_application_assignment.test.virtual_machine_id
+}
+`, r.basic(data))
+}
+
+func (r VirtualMachineGalleryApplicationAssignmentResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_gallery_application_assignment" "test" {
+  gallery_application_version_id = azurerm_gallery_application_version.test.id
+  virtual_machine_id             = azurerm_linux_virtual_machine.test.id
+  configuration_blob_uri         = azurerm_storage_blob.test2.id
+  order                          = 1
+  tag                            = "app"
+}
+`, LinuxVirtualMachineResource{}.otherGalleryApplicationRemoved(data))
+}
+
+func (r VirtualMachineGalleryApplicationAssignmentResource) withVirtualMachineGalleryApplication(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_gallery_application_assignment" "test" {
+  gallery_application_version_id = azurerm_gallery_application_version.test2.id
+  virtual_machine_id             = azurerm_linux_virtual_machine.test.id
+}
+`, LinuxVirtualMachineResource{}.otherGalleryApplication(data))
+}
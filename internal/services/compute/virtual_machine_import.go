@@ -60,6 +60,12 @@ func importVirtualMachine(osType compute.OperatingSystemTypes, resourceType stri
 			d.Set("admin_password", "ignored-as-imported")
 		}
 
+		// the Gallery Applications are tracked by the Read function based on those already in the state
+		// so when importing we assume that all of the Gallery Applications are managed by this resource
+		if profile := vm.VirtualMachineProperties.ApplicationProfile; profile != nil && profile.GalleryApplications != nil {
+			d.Set("gallery_application", flattenVirtualMachineGalleryApplication(profile.GalleryApplications))
+		}
+
 		return []*pluginsdk.ResourceData{d}, nil
 	}
 }
@@ -0,0 +1,249 @@
+package compute
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/kermit/sdk/compute/2022-08-01/compute"
+)
+
+type VirtualMachineScaleSetGalleryApplicationAssignmentResource struct{}
+
+var _ sdk.Resource = VirtualMachineScaleSetGalleryApplicationAssignmentResource{}
+
+type VirtualMachineScaleSetGalleryApplicationAssignmentModel struct {
+	GalleryApplicationVersionId string `tfschema:"gallery_application_version_id"`
+	VirtualMachineScaleSetId    string `tfschema:"virtual_machine_scale_set_id"`
+	ConfigurationBlobUri        string `tfschema:"configuration_blob_uri"`
+	Order                       int64  `tfschema:"order"`
+	Tag                         string `tfschema:"tag"`
+}
+
+func (r VirtualMachineScaleSetGalleryApplicationAssignmentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"gallery_application_version_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.GalleryApplicationVersionID,
+		},
+
+		"virtual_machine_scale_set_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.VirtualMachineScaleSetID,
+		},
+
+		// Example: https://mystorageaccount.blob.core.windows.net/configurations/settings.config
+		"configuration_blob_uri": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+		},
+
+		"order": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      0,
+			ValidateFunc: validation.IntBetween(0, 2147483647),
+		},
+
+		// NOTE: Per the service team, "this is a pass through value that we just add to the model but don't depend on. It can be any string."
+		"tag": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r VirtualMachineScaleSetGalleryApplicationAssignmentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r VirtualMachineScaleSetGalleryApplicationAssignmentResource) ResourceType() string {
+	return "azurerm_virtual_machine_scale_set_gallery_application_assignment"
+}
+
+func (r VirtualMachineScaleSetGalleryApplicationAssignmentResource) ModelObject() interface{} {
+	return &VirtualMachineScaleSetGalleryApplicationAssignmentModel{}
+}
+
+func (r VirtualMachineScaleSetGalleryApplicationAssignmentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.VirtualMachineScaleSetGalleryApplicationAssignmentID
+}
+
+func (r VirtualMachineScaleSetGalleryApplicationAssignmentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var state VirtualMachineScaleSetGalleryApplicationAssignmentModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			client := metadata.Client.Compute.VMScaleSetClient
+
+			virtualMachineScaleSetId, err := parse.VirtualMachineScaleSetID(state.VirtualMachineScaleSetId)
+			if err != nil {
+				return err
+			}
+
+			versionId, err := parse.GalleryApplicationVersionID(state.GalleryApplicationVersionId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewVirtualMachineScaleSetGalleryApplicationAssignmentID(*virtualMachineScaleSetId, *versionId)
+
+			locks.ByName(virtualMachineScaleSetId.Name, VirtualMachineScaleSetResourceName)
+			defer locks.UnlockByName(virtualMachineScaleSetId.Name, VirtualMachineScaleSetResourceName)
+
+			existing, err := client.Get(ctx, virtualMachineScaleSetId.ResourceGroup, virtualMachineScaleSetId.Name, "")
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *virtualMachineScaleSetId, err)
+			}
+
+			galleryApplications := make([]compute.VMGalleryApplication, 0)
+			if props := existing.VirtualMachineScaleSetProperties; props != nil && props.VirtualMachineProfile != nil && props.VirtualMachineProfile.ApplicationProfile != nil && props.VirtualMachineProfile.ApplicationProfile.GalleryApplications != nil {
+				galleryApplications = *props.VirtualMachineProfile.ApplicationProfile.GalleryApplications
+			}
+
+			for _, v := range galleryApplications {
+				if v.PackageReferenceID != nil && strings.EqualFold(*v.PackageReferenceID, versionId.ID()) {
+					return metadata.ResourceRequiresImport(r.ResourceType(), id)
+				}
+			}
+
+			galleryApplications = append(galleryApplications, expandGalleryApplicationAssignment(versionId.ID(), state.ConfigurationBlobUri, state.Order, state.Tag))
+
+			if err := updateVirtualMachineScaleSetGalleryApplications(ctx, client, *virtualMachineScaleSetId, existing, &galleryApplications); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+		Timeout: 45 * time.Minute,
+	}
+}
+
+func (r VirtualMachineScaleSetGalleryApplicationAssignmentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VMScaleSetClient
+
+			id, err := parse.VirtualMachineScaleSetGalleryApplicationAssignmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.VirtualMachineScaleSetId.ResourceGroup, id.VirtualMachineScaleSetId.Name, "")
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					metadata.Logger.Infof("%s was not found - removing from state!", id.VirtualMachineScaleSetId)
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", id.VirtualMachineScaleSetId, err)
+			}
+
+			var galleryApplications *[]compute.VMGalleryApplication
+			if props := resp.VirtualMachineScaleSetProperties; props != nil && props.VirtualMachineProfile != nil && props.VirtualMachineProfile.ApplicationProfile != nil {
+				galleryApplications = props.VirtualMachineProfile.ApplicationProfile.GalleryApplications
+			}
+
+			galleryApplication := findGalleryApplicationAssignment(galleryApplications, id.GalleryApplicationVersionId.ID())
+			if galleryApplication == nil {
+				metadata.Logger.Infof("%s was not found - removing from state!", *id)
+				return metadata.MarkAsGone(id)
+			}
+
+			state := VirtualMachineScaleSetGalleryApplicationAssignmentModel{
+				GalleryApplicationVersionId: id.GalleryApplicationVersionId.ID(),
+				VirtualMachineScaleSetId:    id.VirtualMachineScaleSetId.ID(),
+				ConfigurationBlobUri:        utils.NormalizeNilableString(galleryApplication.ConfigurationReference),
+				Tag:                         utils.NormalizeNilableString(galleryApplication.Tags),
+			}
+
+			if galleryApplication.Order != nil {
+				state.Order = int64(*galleryApplication.Order)
+			}
+
+			return metadata.Encode(&state)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r VirtualMachineScaleSetGalleryApplicationAssignmentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.VMScaleSetClient
+
+			id, err := parse.VirtualMachineScaleSetGalleryApplicationAssignmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByName(id.VirtualMachineScaleSetId.Name, VirtualMachineScaleSetResourceName)
+			defer locks.UnlockByName(id.VirtualMachineScaleSetId.Name, VirtualMachineScaleSetResourceName)
+
+			existing, err := client.Get(ctx, id.VirtualMachineScaleSetId.ResourceGroup, id.VirtualMachineScaleSetId.Name, "")
+			if err != nil {
+				if utils.ResponseWasNotFound(existing.Response) {
+					return nil
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", id.VirtualMachineScaleSetId, err)
+			}
+
+			var galleryApplications *[]compute.VMGalleryApplication
+			if props := existing.VirtualMachineScaleSetProperties; props != nil && props.VirtualMachineProfile != nil && props.VirtualMachineProfile.ApplicationProfile != nil {
+				galleryApplications = props.VirtualMachineProfile.ApplicationProfile.GalleryApplications
+			}
+
+			if err := updateVirtualMachineScaleSetGalleryApplications(ctx, client, id.VirtualMachineScaleSetId, existing, removeGalleryApplicationAssignment(galleryApplications, id.GalleryApplicationVersionId.ID())); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+		Timeout: 45 * time.Minute,
+	}
+}
+
+// updateVirtualMachineScaleSetGalleryApplications updates the Gallery Applications within the Virtual Machine Profile
+// of the Virtual Machine Scale Set - since these can't be updated using a PATCH request, the existing model is sent
+func updateVirtualMachineScaleSetGalleryApplications(ctx context.Context, client *compute.VirtualMachineScaleSetsClient, id parse.VirtualMachineScaleSetId, existing compute.VirtualMachineScaleSet, galleryApplications *[]compute.VMGalleryApplication) error {
+	if existing.VirtualMachineScaleSetProperties == nil || existing.VirtualMachineScaleSetProperties.VirtualMachineProfile == nil {
+		return fmt.Errorf("retrieving %s: `properties.virtualMachineProfile` was nil", id)
+	}
+
+	existing.VirtualMachineScaleSetProperties.VirtualMachineProfile.ApplicationProfile = &compute.ApplicationProfile{
+		GalleryApplications: galleryApplications,
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, existing)
+	if err != nil {
+		return err
+	}
+
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the update of %s: %+v", id, err)
+	}
+
+	return nil
+}
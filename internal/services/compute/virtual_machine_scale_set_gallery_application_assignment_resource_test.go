@@ -0,0 +1,161 @@
+package compute_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type VirtualMachineScaleSetGalleryApplicationAssignmentResource struct{}
+
+func TestAccVirtualMachineScaleSetGalleryApplicationAssignment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_scale_set_gallery_application_assignment", "test")
+	r := VirtualMachineScaleSetGalleryApplicationAssignmentResource{}
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualMachineScaleSetGalleryApplicationAssignment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_scale_set_gallery_application_assignment", "test")
+	r := VirtualMachineScaleSetGalleryApplicationAssignmentResource{}
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccVirtualMachineScaleSetGalleryApplicationAssignment_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_machine_scale_set_gallery_application_assignment", "test")
+	r := VirtualMachineScaleSetGalleryApplicationAssignmentResource{}
+	data.ResourceTest(t, r, []resource.TestStep{
+		{
+			Config: r.complete(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r VirtualMachineScaleSetGalleryApplicationAssignmentResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.VirtualMachineScaleSetGalleryApplicationAssignmentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Compute.VMScaleSetClient.Get(ctx, id.VirtualMachineScaleSetId.ResourceGroup, id.VirtualMachineScaleSetId.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id.VirtualMachineScaleSetId, err)
+	}
+
+	if props := resp.VirtualMachineScaleSetProperties; props != nil && props.VirtualMachineProfile != nil && props.VirtualMachineProfile.ApplicationProfile != nil && props.VirtualMachineProfile.ApplicationProfile.GalleryApplications != nil {
+		for _, v := range *props.VirtualMachineProfile.ApplicationProfile.GalleryApplications {
+			if v.PackageReferenceID != nil && *v.PackageReferenceID == id.GalleryApplicationVersionId.ID() {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (r VirtualMachineScaleSetGalleryApplicationAssignmentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_scale_set_gallery_application_assignment" "test" {
+  gallery_application_version_id = azurerm_gallery_application_version.test.id
+  virtual_machine_scale_set_id   = azurerm_linux_virtual_machine_scale_set.test.id
+}
+`, r.template(data))
+}
+
+func (r VirtualMachineScaleSetGalleryApplicationAssignmentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_scale_set_gallery_application_assignment" "import" {
+  gallery_application_version_id = azurerm_virtual_machine_scale_set_gallery_application_assignment.test.gallery_application_version_id
+  virtual_machine_scale_set_id   = azurerm_virtual_machine_scale_set_gallery_application_assignment.test.virtual_machine_scale_set_id
+}
+`, r.basic(data))
+}
+
+func (r VirtualMachineScaleSetGalleryApplicationAssignmentResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_scale_set_gallery_application_assignment" "test" {
+  gallery_application_version_id = azurerm_gallery_application_version.test.id
+  virtual_machine_scale_set_id   = azurerm_linux_virtual_machine_scale_set.test.id
+  configuration_blob_uri         = azurerm_storage_blob.test2.id
+  order                          = 1
+  tag                            = "app"
+}
+`, r.template(data))
+}
+
+func (r VirtualMachineScaleSetGalleryApplicationAssignmentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_linux_virtual_machine_scale_set" "test" {
+  name                = "acctestvmss-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  sku                 = "Standard_F2"
+  instances           = 1
+  admin_username      = "adminuser"
+  admin_password      = "P@ssword1234!"
+
+  disable_password_authentication = false
+
+  source_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+
+  os_disk {
+    storage_account_type = "Standard_LRS"
+    caching              = "ReadWrite"
+  }
+
+  network_interface {
+    name    = "example"
+    primary = true
+
+    ip_configuration {
+      name      = "internal"
+      primary   = true
+      subnet_id = azurerm_subnet.test.id
+    }
+  }
+}
+`, LinuxVirtualMachineScaleSetResource{}.otherGalleryApplicationTemplate(data), data.RandomInteger)
+}
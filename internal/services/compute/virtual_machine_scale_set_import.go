@@ -90,6 +90,12 @@ func importVirtualMachineScaleSet(osType compute.OperatingSystemTypes, resourceT
 		}
 		d.Set("extension", updatedExtensions)
 
+		// the Gallery Applications are tracked by the Read function based on those already in the state
+		// so when importing we assume that all of the Gallery Applications are managed by this resource
+		if profile := vm.VirtualMachineScaleSetProperties.VirtualMachineProfile.ApplicationProfile; profile != nil && profile.GalleryApplications != nil {
+			d.Set("gallery_application", flattenVirtualMachineScaleSetGalleryApplication(profile.GalleryApplications))
+		}
+
 		return []*pluginsdk.ResourceData{d}, nil
 	}
 }
@@ -820,7 +820,11 @@ func resourceWindowsVirtualMachineRead(d *pluginsdk.ResourceData, meta interface
 	d.Set("extensions_time_budget", extensionsTimeBudget)
 
 	if props.ApplicationProfile != nil && props.ApplicationProfile.GalleryApplications != nil {
-		d.Set("gallery_application", flattenVirtualMachineGalleryApplication(props.ApplicationProfile.GalleryApplications))
+		// only the Gallery Applications managed by this resource are tracked, those assigned using the
+		// `azurerm_virtual_machine_gallery_application_assignment` resource are ignored
+		versionIds := galleryApplicationVersionIds(d.Get("gallery_application").([]interface{}), "version_id")
+		galleryApplications := filterGalleryApplications(props.ApplicationProfile.GalleryApplications, versionIds)
+		d.Set("gallery_application", flattenVirtualMachineGalleryApplication(galleryApplications))
 	}
 
 	// defaulted since BillingProfile isn't returned if it's unset
@@ -1192,8 +1196,18 @@ func resourceWindowsVirtualMachineUpdate(d *pluginsdk.ResourceData, meta interfa
 
 	if d.HasChange("gallery_application") {
 		shouldUpdate = true
+
+		var existingGalleryApplications *[]compute.VMGalleryApplication
+		if props := existing.VirtualMachineProperties; props != nil && props.ApplicationProfile != nil {
+			existingGalleryApplications = props.ApplicationProfile.GalleryApplications
+		}
+
+		// merge the changes into the existing Gallery Applications so that any assigned using the
+		// `azurerm_virtual_machine_gallery_application_assignment` resource are retained
+		oldRaw, newRaw := d.GetChange("gallery_application")
+		previousVersionIds := galleryApplicationVersionIds(oldRaw.([]interface{}), "version_id")
 		update.ApplicationProfile = &compute.ApplicationProfile{
-			GalleryApplications: expandVirtualMachineGalleryApplication(d.Get("gallery_application").([]interface{})),
+			GalleryApplications: mergeGalleryApplications(existingGalleryApplications, previousVersionIds, expandVirtualMachineGalleryApplication(newRaw.([]interface{}))),
 		}
 	}
 
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
 	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -59,6 +60,10 @@ func resourceWindowsVirtualMachineScaleSetCreate(d *pluginsdk.ResourceData, meta
 	defer cancel()
 
 	id := parse.NewVirtualMachineScaleSetID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
+
+	locks.ByName(id.Name, VirtualMachineScaleSetResourceName)
+	defer locks.UnlockByName(id.Name, VirtualMachineScaleSetResourceName)
+
 	exists, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
 	if err != nil {
 		if !utils.ResponseWasNotFound(exists.Response) {
@@ -471,6 +476,9 @@ func resourceWindowsVirtualMachineScaleSetUpdate(d *pluginsdk.ResourceData, meta
 		return err
 	}
 
+	locks.ByName(id.Name, VirtualMachineScaleSetResourceName)
+	defer locks.UnlockByName(id.Name, VirtualMachineScaleSetResourceName)
+
 	updateInstances := false
 
 	// retrieve
@@ -949,10 +957,18 @@ func resourceWindowsVirtualMachineScaleSetRead(d *pluginsdk.ResourceData, meta i
 		d.Set("license_type", profile.LicenseType)
 
 		if profile.ApplicationProfile != nil && profile.ApplicationProfile.GalleryApplications != nil {
-			d.Set("gallery_application", flattenVirtualMachineScaleSetGalleryApplication(profile.ApplicationProfile.GalleryApplications))
+			// only the Gallery Applications managed by this resource are tracked, those assigned using the
+			// `azurerm_virtual_machine_scale_set_gallery_application_assignment` resource are ignored
+			versionIds := galleryApplicationVersionIds(d.Get("gallery_application").([]interface{}), "version_id")
+			if !features.FourPointOhBeta() {
+				versionIds = append(versionIds, galleryApplicationVersionIds(d.Get("gallery_applications").([]interface{}), "package_reference_id")...)
+			}
+			galleryApplications := filterGalleryApplications(profile.ApplicationProfile.GalleryApplications, versionIds)
+
+			d.Set("gallery_application", flattenVirtualMachineScaleSetGalleryApplication(galleryApplications))
 
 			if !features.FourPointOhBeta() {
-				d.Set("gallery_applications", flattenVirtualMachineScaleSetGalleryApplications(profile.ApplicationProfile.GalleryApplications))
+				d.Set("gallery_applications", flattenVirtualMachineScaleSetGalleryApplications(galleryApplications))
 			}
 		}
 
@@ -1107,6 +1123,9 @@ func resourceWindowsVirtualMachineScaleSetDelete(d *pluginsdk.ResourceData, meta
 		return err
 	}
 
+	locks.ByName(id.Name, VirtualMachineScaleSetResourceName)
+	defer locks.UnlockByName(id.Name, VirtualMachineScaleSetResourceName)
+
 	resp, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
@@ -6,11 +6,15 @@ import (
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerinstance/2021-10-01/containerinstance"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2022-09-02-preview/agentpools"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2022-09-02-preview/maintenanceconfigurations"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2022-09-02-preview/managedclusters"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-03-02-preview/maintenanceconfigurations"
 )
 
+// NOTE: only the Maintenance Configuration models/methods are embedded since the vendored SDK uses API Version
+// `2022-09-02-preview`, which doesn't include the `maintenanceWindow` schedules - the embedded package reuses the
+// vendored types/IDs and should be removed once `go-azure-sdk` is bumped to a version including `2023-03-02-preview`
+
 type Client struct {
 	AgentPoolsClient                  *agentpools.AgentPoolsClient
 	ContainerRegistryAgentPoolsClient *containerregistry.AgentPoolsClient
@@ -1,6 +1,7 @@
 package containers
 
 import (
+	"context"
 	"encoding/base64"
 	"fmt"
 	"log"
@@ -54,12 +55,22 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 			0: migration.KubernetesClusterNodePoolV0ToV1{},
 		}),
 
+		CustomizeDiff: pluginsdk.CustomDiffInSequence(
+			forceNewIfNodePoolCannotBeRotated("", func(d *pluginsdk.ResourceDiff) bool {
+				return d.Get("rotation_enabled").(bool)
+			}),
+		),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				ForceNew:     true,
 				ValidateFunc: containerValidate.KubernetesAgentPoolName,
+				// once rotated the Node Pool uses a name derived from the configured name
+				DiffSuppressFunc: func(_, old, new string, d *pluginsdk.ResourceData) bool {
+					return old != "" && old == nodePoolRotationName(new, d.Get("os_type").(string))
+				},
 			},
 
 			"kubernetes_cluster_id": {
@@ -81,7 +92,6 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 			"vm_size": {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
-				ForceNew:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
@@ -234,7 +244,6 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 			"os_disk_type": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
-				ForceNew: true,
 				Default:  agentpools.OSDiskTypeManaged,
 				ValidateFunc: validation.StringInSlice([]string{
 					string(agentpools.OSDiskTypeEphemeral),
@@ -274,6 +283,16 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 				ValidateFunc: networkValidate.SubnetID,
 			},
 
+			"power_state": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(agentpools.CodeRunning),
+					string(agentpools.CodeStopped),
+				}, false),
+			},
+
 			"priority": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -300,6 +319,12 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 				ValidateFunc: computeValidate.SpotMaxPrice,
 			},
 
+			"rotation_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"scale_down_mode": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -320,7 +345,6 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 			"vnet_subnet_id": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
-				ForceNew:     true,
 				ValidateFunc: azure.ValidateResourceID,
 			},
 
@@ -351,7 +375,7 @@ func resourceKubernetesClusterNodePool() *pluginsdk.Resource {
 					string(agentpools.WorkloadRuntimeWasmWasi),
 				}, false),
 			},
-			"zones": commonschema.ZonesMultipleOptionalForceNew(),
+			"zones": commonschema.ZonesMultipleOptional(),
 		},
 	}
 }
@@ -590,6 +614,14 @@ func resourceKubernetesClusterNodePoolCreate(d *pluginsdk.ResourceData, meta int
 	}
 
 	d.SetId(id.ID())
+
+	if d.Get("power_state").(string) == string(agentpools.CodeStopped) {
+		log.Printf("[DEBUG] Stopping %s..", id)
+		if err := updateKubernetesClusterNodePoolPowerState(ctx, poolsClient, id, agentpools.CodeStopped); err != nil {
+			return err
+		}
+	}
+
 	return resourceKubernetesClusterNodePoolRead(d, meta)
 }
 
@@ -606,6 +638,14 @@ func resourceKubernetesClusterNodePoolUpdate(d *pluginsdk.ResourceData, meta int
 
 	d.Partial(true)
 
+	// a stopped node pool can't be updated, so it needs to be started before any other changes are made
+	if d.HasChange("power_state") && d.Get("power_state").(string) == string(agentpools.CodeRunning) {
+		log.Printf("[DEBUG] Starting %s..", *id)
+		if err := updateKubernetesClusterNodePoolPowerState(ctx, client, *id, agentpools.CodeRunning); err != nil {
+			return err
+		}
+	}
+
 	log.Printf("[DEBUG] Retrieving existing %s..", *id)
 	existing, err := client.Get(ctx, *id)
 	if err != nil {
@@ -710,6 +750,25 @@ func resourceKubernetesClusterNodePoolUpdate(d *pluginsdk.ResourceData, meta int
 		props.NodeLabels = expandNodeLabels(d.Get("node_labels").(map[string]interface{}))
 	}
 
+	if d.HasChange("os_disk_type") {
+		props.OsDiskType = utils.ToPtr(agentpools.OSDiskType(d.Get("os_disk_type").(string)))
+	}
+
+	if d.HasChange("vm_size") {
+		props.VMSize = utils.String(d.Get("vm_size").(string))
+	}
+
+	if d.HasChange("vnet_subnet_id") {
+		if vnetSubnetID := d.Get("vnet_subnet_id").(string); vnetSubnetID != "" {
+			props.VnetSubnetID = utils.String(vnetSubnetID)
+		}
+	}
+
+	if d.HasChange("zones") {
+		zones := zones.ExpandUntyped(d.Get("zones").(*schema.Set).List())
+		props.AvailabilityZones = &zones
+	}
+
 	// validate the auto-scale fields are both set/unset to prevent a continual diff
 	maxCount := 0
 	if props.MaxCount != nil {
@@ -737,15 +796,43 @@ func resourceKubernetesClusterNodePoolUpdate(d *pluginsdk.ResourceData, meta int
 		props.MinCount = nil
 	}
 
-	log.Printf("[DEBUG] Updating existing %s..", *id)
 	existing.Model.Properties = props
-	future, err := client.CreateOrUpdate(ctx, *id, *existing.Model)
-	if err != nil {
-		return fmt.Errorf("updating Node Pool %s: %+v", *id, err)
+
+	if d.HasChanges(nodePoolRotationProperties...) {
+		// these properties can't be updated in-place, instead the Node Pool is rotated into a new Node Pool so that
+		// the workloads can be rescheduled rather than all being drained at once. Rotations alternate between the
+		// configured name and a name derived from it, since the name of a Node Pool can't be changed
+		name := d.GetRawConfig().AsValueMap()["name"].AsString()
+		if id.AgentPoolName == name {
+			name = nodePoolRotationName(name, d.Get("os_type").(string))
+		}
+
+		log.Printf("[DEBUG] Rotating existing %s into Node Pool %q..", *id, name)
+		newId, err := rotateNodePool(ctx, client, *id, name, *existing.Model)
+		if err != nil {
+			return fmt.Errorf("rotating %s: %+v", *id, err)
+		}
+
+		id = newId
+		d.SetId(id.ID())
+	} else {
+		log.Printf("[DEBUG] Updating existing %s..", *id)
+		future, err := client.CreateOrUpdate(ctx, *id, *existing.Model)
+		if err != nil {
+			return fmt.Errorf("updating Node Pool %s: %+v", *id, err)
+		}
+
+		if err = future.Poller.PollUntilDone(); err != nil {
+			return fmt.Errorf("waiting for update of %s: %+v", *id, err)
+		}
 	}
 
-	if err = future.Poller.PollUntilDone(); err != nil {
-		return fmt.Errorf("waiting for update of %s: %+v", *id, err)
+	// stopping the node pool is done last so that the other changes can be applied whilst it's running
+	if d.HasChange("power_state") && d.Get("power_state").(string) == string(agentpools.CodeStopped) {
+		log.Printf("[DEBUG] Stopping %s..", *id)
+		if err := updateKubernetesClusterNodePoolPowerState(ctx, client, *id, agentpools.CodeStopped); err != nil {
+			return err
+		}
 	}
 
 	d.Partial(false)
@@ -793,8 +880,15 @@ func resourceKubernetesClusterNodePoolRead(d *pluginsdk.ResourceData, meta inter
 
 	if model := resp.Model; model != nil && model.Properties != nil {
 		props := model.Properties
+
+		// the auto-scaling settings of a stopped Node Pool aren't returned by the API - so the existing values are
+		// retained from the state to avoid a diff whilst the Node Pool is stopped
+		stopped := props.PowerState != nil && props.PowerState.Code != nil && *props.PowerState.Code == agentpools.CodeStopped
+
 		d.Set("zones", zones.FlattenUntyped(props.AvailabilityZones))
-		d.Set("enable_auto_scaling", props.EnableAutoScaling)
+		if !stopped {
+			d.Set("enable_auto_scaling", props.EnableAutoScaling)
+		}
 		d.Set("enable_node_public_ip", props.EnableNodePublicIP)
 		d.Set("enable_host_encryption", props.EnableEncryptionAtHost)
 		d.Set("custom_ca_trust_enabled", props.EnableCustomCATrust)
@@ -833,11 +927,13 @@ func resourceKubernetesClusterNodePoolRead(d *pluginsdk.ResourceData, meta inter
 			return fmt.Errorf("setting `linux_os_config`: %+v", err)
 		}
 
-		maxCount := 0
-		if props.MaxCount != nil {
-			maxCount = int(*props.MaxCount)
+		if !stopped {
+			maxCount := 0
+			if props.MaxCount != nil {
+				maxCount = int(*props.MaxCount)
+			}
+			d.Set("max_count", maxCount)
 		}
-		d.Set("max_count", maxCount)
 
 		messageOfTheDay := ""
 		if props.MessageOfTheDay != nil {
@@ -855,11 +951,13 @@ func resourceKubernetesClusterNodePoolRead(d *pluginsdk.ResourceData, meta inter
 		}
 		d.Set("max_pods", maxPods)
 
-		minCount := 0
-		if props.MinCount != nil {
-			minCount = int(*props.MinCount)
+		if !stopped {
+			minCount := 0
+			if props.MinCount != nil {
+				minCount = int(*props.MinCount)
+			}
+			d.Set("min_count", minCount)
 		}
-		d.Set("min_count", minCount)
 
 		mode := string(managedclusters.AgentPoolModeUser)
 		if v := props.Mode; v != nil && *v != "" {
@@ -867,10 +965,20 @@ func resourceKubernetesClusterNodePoolRead(d *pluginsdk.ResourceData, meta inter
 		}
 		d.Set("mode", mode)
 
+		powerState := string(agentpools.CodeRunning)
+		if props.PowerState != nil && props.PowerState.Code != nil {
+			powerState = string(*props.PowerState.Code)
+		}
+		d.Set("power_state", powerState)
+
 		count := 0
 		if props.Count != nil {
 			count = int(*props.Count)
 		}
+		// the nodes of a stopped node pool are deallocated, so retain the configured count to avoid a diff
+		if powerState == string(agentpools.CodeStopped) && count == 0 {
+			count = d.Get("node_count").(int)
+		}
 		d.Set("node_count", count)
 
 		if err := d.Set("node_labels", props.NodeLabels); err != nil {
@@ -966,6 +1074,31 @@ func resourceKubernetesClusterNodePoolDelete(d *pluginsdk.ResourceData, meta int
 	return nil
 }
 
+func updateKubernetesClusterNodePoolPowerState(ctx context.Context, client *agentpools.AgentPoolsClient, id agentpools.AgentPoolId, code agentpools.Code) error {
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if existing.Model == nil || existing.Model.Properties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", id)
+	}
+
+	existing.Model.Properties.PowerState = &agentpools.PowerState{
+		Code: utils.ToPtr(code),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id, *existing.Model)
+	if err != nil {
+		return fmt.Errorf("updating the power state of %s to %q: %+v", id, string(code), err)
+	}
+
+	if err := future.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("waiting for the power state of %s to be updated to %q: %+v", id, string(code), err)
+	}
+
+	return nil
+}
+
 func upgradeSettingsSchema() *pluginsdk.Schema {
 	return &pluginsdk.Schema{
 		Type:     pluginsdk.TypeList,
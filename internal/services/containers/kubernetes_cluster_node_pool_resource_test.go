@@ -338,6 +338,106 @@ func TestAccKubernetesClusterNodePool_manualScaleVMSku(t *testing.T) {
 	})
 }
 
+func TestAccKubernetesClusterNodePool_rotation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_node_pool", "test")
+	r := KubernetesClusterNodePoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.rotationConfig(data, "Standard_DS2_v2", "Managed"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("name").HasValue("internal"),
+			),
+		},
+		data.ImportStep("rotation_enabled"),
+		{
+			Config: r.rotationConfig(data, "Standard_DS3_v2", "Managed"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("name").HasValue("internalr"),
+				check.That(data.ResourceName).Key("vm_size").HasValue("Standard_DS3_v2"),
+			),
+		},
+		data.ImportStep("rotation_enabled"),
+		{
+			Config: r.rotationConfig(data, "Standard_DS3_v2", "Ephemeral"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("name").HasValue("internal"),
+				check.That(data.ResourceName).Key("os_disk_type").HasValue("Ephemeral"),
+			),
+		},
+		data.ImportStep("rotation_enabled"),
+	})
+}
+
+func TestAccKubernetesClusterNodePool_powerState(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_node_pool", "test")
+	r := KubernetesClusterNodePoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.powerStateConfig(data, "Stopped"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("power_state").HasValue("Stopped"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.powerStateConfig(data, "Running"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("power_state").HasValue("Running"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.powerStateConfig(data, "Stopped"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("power_state").HasValue("Stopped"),
+				check.That(data.ResourceName).Key("node_count").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKubernetesClusterNodePool_powerStateAutoScaling(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_node_pool", "test")
+	r := KubernetesClusterNodePoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.powerStateAutoScalingConfig(data, "Running"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("power_state").HasValue("Running"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.powerStateAutoScalingConfig(data, "Stopped"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("power_state").HasValue("Stopped"),
+				check.That(data.ResourceName).Key("min_count").HasValue("1"),
+				check.That(data.ResourceName).Key("max_count").HasValue("3"),
+			),
+		},
+		{
+			Config: r.powerStateAutoScalingConfig(data, "Running"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("power_state").HasValue("Running"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccKubernetesClusterNodePool_modeSystem(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster_node_pool", "test")
 	r := KubernetesClusterNodePoolResource{}
@@ -1341,6 +1441,63 @@ resource "azurerm_kubernetes_cluster_node_pool" "test" {
 `, r.templateConfig(data))
 }
 
+func (r KubernetesClusterNodePoolResource) powerStateAutoScalingConfig(data acceptance.TestData, powerState string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_kubernetes_cluster_node_pool" "test" {
+  name                  = "internal"
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+  vm_size               = "Standard_DS2_v2"
+  enable_auto_scaling   = true
+  min_count             = 1
+  max_count             = 3
+  power_state           = %q
+}
+`, r.templateConfig(data), powerState)
+}
+
+func (r KubernetesClusterNodePoolResource) powerStateConfig(data acceptance.TestData, powerState string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_kubernetes_cluster_node_pool" "test" {
+  name                  = "internal"
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+  vm_size               = "Standard_DS2_v2"
+  node_count            = 1
+  power_state           = %q
+}
+`, r.templateConfig(data), powerState)
+}
+
+func (r KubernetesClusterNodePoolResource) rotationConfig(data acceptance.TestData, vmSize string, osDiskType string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_kubernetes_cluster_node_pool" "test" {
+  name                  = "internal"
+  kubernetes_cluster_id = azurerm_kubernetes_cluster.test.id
+  vm_size               = %q
+  os_disk_type          = %q
+  node_count            = 1
+  rotation_enabled      = true
+}
+`, r.templateConfig(data), vmSize, osDiskType)
+}
+
 func (r KubernetesClusterNodePoolResource) manualScaleIgnoreChangesConfig(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
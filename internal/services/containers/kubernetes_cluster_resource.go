@@ -5,6 +5,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"log"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -30,6 +31,7 @@ import (
 	computeValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/kubernetes"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/migration"
+	maintenancewindows "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/sdk/2023-03-02-preview/maintenanceconfigurations"
 	containerValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/containers/validate"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -68,6 +70,11 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 			pluginsdk.ForceNewIfChange("api_server_access_profile.0.subnet_id", func(ctx context.Context, old, new, meta interface{}) bool {
 				return old != "" && new == ""
 			}),
+			forceNewIfNodePoolCannotBeRotated("default_node_pool.0.", func(d *pluginsdk.ResourceDiff) bool {
+				return d.Get("default_node_pool.0.temporary_name_for_rotation").(string) != ""
+			}),
+			validateKubernetesClusterMaintenanceWindowSchedule("maintenance_window_auto_upgrade"),
+			validateKubernetesClusterMaintenanceWindowSchedule("maintenance_window_node_os"),
 		),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
@@ -709,6 +716,19 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 				},
 			},
 
+			"maintenance_window_auto_upgrade": schemaKubernetesClusterMaintenanceWindowSchedule([]string{
+				"Weekly",
+				"AbsoluteMonthly",
+				"RelativeMonthly",
+			}),
+
+			"maintenance_window_node_os": schemaKubernetesClusterMaintenanceWindowSchedule([]string{
+				"Daily",
+				"Weekly",
+				"AbsoluteMonthly",
+				"RelativeMonthly",
+			}),
+
 			"microsoft_defender": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -1035,6 +1055,16 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 				),
 			},
 
+			"power_state": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(managedclusters.CodeRunning),
+					string(managedclusters.CodeStopped),
+				}, false),
+			},
+
 			"public_network_access_enabled": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
@@ -1221,6 +1251,144 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 	return resource
 }
 
+// validateKubernetesClusterMaintenanceWindowSchedule checks that the Maintenance Window found at `key` specifies the
+// properties required by its `frequency` and that `interval` is within the range supported for that frequency
+func validateKubernetesClusterMaintenanceWindowSchedule(key string) pluginsdk.CustomizeDiffFunc {
+	return func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+		if len(d.Get(key).([]interface{})) == 0 || !d.NewValueKnown(key+".0.frequency") {
+			return nil
+		}
+		prefix := key + ".0."
+
+		frequency := d.Get(prefix + "frequency").(string)
+		maxInterval := 0
+		switch frequency {
+		case "Daily":
+			maxInterval = 7
+		case "Weekly":
+			if d.NewValueKnown(prefix+"day_of_week") && d.Get(prefix+"day_of_week").(string) == "" {
+				return fmt.Errorf("`%s.day_of_week` must be specified when `frequency` is `Weekly`", key)
+			}
+			maxInterval = 4
+		case "AbsoluteMonthly":
+			if d.NewValueKnown(prefix+"day_of_month") && d.Get(prefix+"day_of_month").(int) == 0 {
+				return fmt.Errorf("`%s.day_of_month` must be specified when `frequency` is `AbsoluteMonthly`", key)
+			}
+			maxInterval = 6
+		case "RelativeMonthly":
+			if (d.NewValueKnown(prefix+"day_of_week") && d.Get(prefix+"day_of_week").(string) == "") || (d.NewValueKnown(prefix+"week_index") && d.Get(prefix+"week_index").(string) == "") {
+				return fmt.Errorf("`%s.day_of_week` and `%s.week_index` must be specified when `frequency` is `RelativeMonthly`", key, key)
+			}
+			maxInterval = 6
+		}
+
+		if d.NewValueKnown(prefix+"interval") && maxInterval > 0 {
+			if interval := d.Get(prefix + "interval").(int); interval > maxInterval {
+				return fmt.Errorf("`%s.interval` must be between `1` and `%d` when `frequency` is `%s`, got %d", key, maxInterval, frequency, interval)
+			}
+		}
+
+		return nil
+	}
+}
+
+func schemaKubernetesClusterMaintenanceWindowSchedule(frequencies []string) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"frequency": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(frequencies, false),
+				},
+
+				"interval": {
+					Type:         pluginsdk.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(1, 7),
+				},
+
+				"duration": {
+					Type:         pluginsdk.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(4, 24),
+				},
+
+				"day_of_week": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice(maintenanceconfigurations.PossibleValuesForWeekDay(), false),
+				},
+
+				"week_index": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice(maintenancewindows.PossibleValuesForType(), false),
+				},
+
+				"day_of_month": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntBetween(1, 31),
+				},
+
+				"start_date": {
+					Type:             pluginsdk.TypeString,
+					Optional:         true,
+					Computed:         true,
+					DiffSuppressFunc: suppress.RFC3339Time,
+					ValidateFunc:     validation.IsRFC3339Time,
+				},
+
+				"start_time": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					Computed: true,
+					ValidateFunc: validation.StringMatch(
+						regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`),
+						"`start_time` must be in the format `HH:mm`",
+					),
+				},
+
+				"utc_offset": {
+					Type:     pluginsdk.TypeString,
+					Optional: true,
+					Computed: true,
+					ValidateFunc: validation.StringMatch(
+						regexp.MustCompile(`^(-|\+)[0-9]{2}:[0-9]{2}$`),
+						"`utc_offset` must be in the format `+HH:mm` or `-HH:mm`",
+					),
+				},
+
+				"not_allowed": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"end": {
+								Type:             pluginsdk.TypeString,
+								Required:         true,
+								DiffSuppressFunc: suppress.RFC3339Time,
+								ValidateFunc:     validation.IsRFC3339Time,
+							},
+
+							"start": {
+								Type:             pluginsdk.TypeString,
+								Required:         true,
+								DiffSuppressFunc: suppress.RFC3339Time,
+								ValidateFunc:     validation.IsRFC3339Time,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func resourceKubernetesClusterCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	tenantId := meta.(*clients.Client).Account.TenantId
@@ -1460,6 +1628,16 @@ func resourceKubernetesClusterCreate(d *pluginsdk.ResourceData, meta interface{}
 		parameters.Properties.IngressProfile = ingressProfile
 	}
 
+	autoUpgradeSchedule, err := expandKubernetesClusterMaintenanceWindowSchedule(d.Get("maintenance_window_auto_upgrade").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `maintenance_window_auto_upgrade`: %+v", err)
+	}
+
+	nodeOSUpgradeSchedule, err := expandKubernetesClusterMaintenanceWindowSchedule(d.Get("maintenance_window_node_os").([]interface{}))
+	if err != nil {
+		return fmt.Errorf("expanding `maintenance_window_node_os`: %+v", err)
+	}
+
 	future, err := client.CreateOrUpdate(ctx, id, parameters)
 	if err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
@@ -1469,9 +1647,11 @@ func resourceKubernetesClusterCreate(d *pluginsdk.ResourceData, meta interface{}
 		return fmt.Errorf("waiting for creation of %s: %+v", id, err)
 	}
 
+	d.SetId(id.ID())
+
 	if maintenanceConfigRaw, ok := d.GetOk("maintenance_window"); ok {
 		client := meta.(*clients.Client).Containers.MaintenanceConfigurationsClient
-		parameters := maintenanceconfigurations.MaintenanceConfiguration{
+		parameters := maintenancewindows.MaintenanceConfiguration{
 			Properties: expandKubernetesClusterMaintenanceConfiguration(maintenanceConfigRaw.([]interface{})),
 		}
 		maintenanceId := maintenanceconfigurations.NewMaintenanceConfigurationID(id.SubscriptionId, id.ResourceGroupName, id.ResourceName, "default")
@@ -1480,7 +1660,39 @@ func resourceKubernetesClusterCreate(d *pluginsdk.ResourceData, meta interface{}
 		}
 	}
 
-	d.SetId(id.ID())
+	if autoUpgradeSchedule != nil {
+		client := meta.(*clients.Client).Containers.MaintenanceConfigurationsClient
+		parameters := maintenancewindows.MaintenanceConfiguration{
+			Properties: &maintenancewindows.MaintenanceConfigurationProperties{
+				MaintenanceWindow: autoUpgradeSchedule,
+			},
+		}
+		maintenanceId := maintenanceconfigurations.NewMaintenanceConfigurationID(id.SubscriptionId, id.ResourceGroupName, id.ResourceName, "aksManagedAutoUpgradeSchedule")
+		if _, err := client.CreateOrUpdate(ctx, maintenanceId, parameters); err != nil {
+			return fmt.Errorf("creating/updating auto upgrade schedule maintenance config for %s: %+v", id, err)
+		}
+	}
+
+	if nodeOSUpgradeSchedule != nil {
+		client := meta.(*clients.Client).Containers.MaintenanceConfigurationsClient
+		parameters := maintenancewindows.MaintenanceConfiguration{
+			Properties: &maintenancewindows.MaintenanceConfigurationProperties{
+				MaintenanceWindow: nodeOSUpgradeSchedule,
+			},
+		}
+		maintenanceId := maintenanceconfigurations.NewMaintenanceConfigurationID(id.SubscriptionId, id.ResourceGroupName, id.ResourceName, "aksManagedNodeOSUpgradeSchedule")
+		if _, err := client.CreateOrUpdate(ctx, maintenanceId, parameters); err != nil {
+			return fmt.Errorf("creating/updating node os upgrade schedule maintenance config for %s: %+v", id, err)
+		}
+	}
+
+	if d.Get("power_state").(string) == string(managedclusters.CodeStopped) {
+		log.Printf("[DEBUG] Stopping %s..", id)
+		if err := client.StopThenPoll(ctx, id); err != nil {
+			return fmt.Errorf("stopping %s: %+v", id, err)
+		}
+	}
+
 	return resourceKubernetesClusterRead(d, meta)
 }
 
@@ -1499,6 +1711,14 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 
 	d.Partial(true)
 
+	// a stopped cluster can't be updated, so it needs to be started before any other changes are made
+	if d.HasChange("power_state") && d.Get("power_state").(string) == string(managedclusters.CodeRunning) {
+		log.Printf("[DEBUG] Starting %s..", *id)
+		if err := clusterClient.StartThenPoll(ctx, *id); err != nil {
+			return fmt.Errorf("starting %s: %+v", *id, err)
+		}
+	}
+
 	// we need to conditionally update the cluster
 	existing, err := clusterClient.Get(ctx, *id)
 	if err != nil {
@@ -1966,20 +2186,36 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 			}
 		}
 
-		agentPool, err := nodePoolsClient.CreateOrUpdate(ctx, defaultNodePoolId, agentProfile)
-		if err != nil {
-			return fmt.Errorf("updating Default Node Pool %s %+v", defaultNodePoolId, err)
+		cycleProperties := make([]string, 0)
+		for _, property := range nodePoolRotationProperties {
+			cycleProperties = append(cycleProperties, "default_node_pool.0."+property)
 		}
 
-		if err := agentPool.Poller.PollUntilDone(); err != nil {
-			return fmt.Errorf("waiting for update of Default Node Pool %s: %+v", defaultNodePoolId, err)
+		if d.HasChanges(cycleProperties...) {
+			// these properties can't be updated in-place, instead the Default Node Pool is cycled through a
+			// temporary System Node Pool so that the cluster retains capacity throughout
+			log.Printf("[DEBUG] Cycling Default Node Pool..")
+			temporaryName := d.Get("default_node_pool.0.temporary_name_for_rotation").(string)
+			if err := cycleNodePool(ctx, nodePoolsClient, defaultNodePoolId, temporaryName, agentProfile); err != nil {
+				return fmt.Errorf("cycling Default Node Pool %s: %+v", defaultNodePoolId, err)
+			}
+			log.Printf("[DEBUG] Cycled Default Node Pool.")
+		} else {
+			agentPool, err := nodePoolsClient.CreateOrUpdate(ctx, defaultNodePoolId, agentProfile)
+			if err != nil {
+				return fmt.Errorf("updating Default Node Pool %s %+v", defaultNodePoolId, err)
+			}
+
+			if err := agentPool.Poller.PollUntilDone(); err != nil {
+				return fmt.Errorf("waiting for update of Default Node Pool %s: %+v", defaultNodePoolId, err)
+			}
+			log.Printf("[DEBUG] Updated Default Node Pool.")
 		}
-		log.Printf("[DEBUG] Updated Default Node Pool.")
 	}
 
 	if d.HasChange("maintenance_window") {
 		client := meta.(*clients.Client).Containers.MaintenanceConfigurationsClient
-		parameters := maintenanceconfigurations.MaintenanceConfiguration{
+		parameters := maintenancewindows.MaintenanceConfiguration{
 			Properties: expandKubernetesClusterMaintenanceConfiguration(d.Get("maintenance_window").([]interface{})),
 		}
 		maintenanceId := maintenanceconfigurations.NewMaintenanceConfigurationID(id.SubscriptionId, id.ResourceGroupName, id.ResourceName, "default")
@@ -1988,6 +2224,30 @@ func resourceKubernetesClusterUpdate(d *pluginsdk.ResourceData, meta interface{}
 		}
 	}
 
+	if d.HasChange("maintenance_window_auto_upgrade") {
+		client := meta.(*clients.Client).Containers.MaintenanceConfigurationsClient
+		maintenanceId := maintenanceconfigurations.NewMaintenanceConfigurationID(id.SubscriptionId, id.ResourceGroupName, id.ResourceName, "aksManagedAutoUpgradeSchedule")
+		if err := updateKubernetesClusterMaintenanceWindowSchedule(ctx, client, maintenanceId, d.Get("maintenance_window_auto_upgrade").([]interface{})); err != nil {
+			return fmt.Errorf("updating `maintenance_window_auto_upgrade`: %+v", err)
+		}
+	}
+
+	if d.HasChange("maintenance_window_node_os") {
+		client := meta.(*clients.Client).Containers.MaintenanceConfigurationsClient
+		maintenanceId := maintenanceconfigurations.NewMaintenanceConfigurationID(id.SubscriptionId, id.ResourceGroupName, id.ResourceName, "aksManagedNodeOSUpgradeSchedule")
+		if err := updateKubernetesClusterMaintenanceWindowSchedule(ctx, client, maintenanceId, d.Get("maintenance_window_node_os").([]interface{})); err != nil {
+			return fmt.Errorf("updating `maintenance_window_node_os`: %+v", err)
+		}
+	}
+
+	// stopping the cluster is done last so that the other changes can be applied whilst it's running
+	if d.HasChange("power_state") && d.Get("power_state").(string) == string(managedclusters.CodeStopped) {
+		log.Printf("[DEBUG] Stopping %s..", *id)
+		if err := clusterClient.StopThenPoll(ctx, *id); err != nil {
+			return fmt.Errorf("stopping %s: %+v", *id, err)
+		}
+	}
+
 	d.Partial(false)
 
 	return resourceKubernetesClusterRead(d, meta)
@@ -2062,6 +2322,12 @@ func resourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{})
 		}
 		d.Set("automatic_channel_upgrade", upgradeChannel)
 
+		powerState := string(managedclusters.CodeRunning)
+		if props.PowerState != nil && props.PowerState.Code != nil {
+			powerState = string(*props.PowerState.Code)
+		}
+		d.Set("power_state", powerState)
+
 		enablePrivateCluster := false
 		enablePrivateClusterPublicFQDN := false
 		runCommandEnabled := true
@@ -2267,6 +2533,32 @@ func resourceKubernetesClusterRead(d *pluginsdk.ResourceData, meta interface{})
 		d.Set("maintenance_window", flattenKubernetesClusterMaintenanceConfiguration(configurationBody.Properties))
 	}
 
+	autoUpgradeScheduleId := maintenanceconfigurations.NewMaintenanceConfigurationID(id.SubscriptionId, id.ResourceGroupName, id.ResourceName, "aksManagedAutoUpgradeSchedule")
+	autoUpgradeScheduleResp, err := maintenanceConfigurationsClient.Get(ctx, autoUpgradeScheduleId)
+	if err != nil && !response.WasNotFound(autoUpgradeScheduleResp.HttpResponse) {
+		return fmt.Errorf("retrieving auto upgrade schedule maintenance config for %s: %+v", *id, err)
+	}
+	autoUpgradeSchedule := make([]interface{}, 0)
+	if model := autoUpgradeScheduleResp.Model; model != nil && model.Properties != nil {
+		autoUpgradeSchedule = flattenKubernetesClusterMaintenanceWindowSchedule(model.Properties.MaintenanceWindow)
+	}
+	if err := d.Set("maintenance_window_auto_upgrade", autoUpgradeSchedule); err != nil {
+		return fmt.Errorf("setting `maintenance_window_auto_upgrade`: %+v", err)
+	}
+
+	nodeOSUpgradeScheduleId := maintenanceconfigurations.NewMaintenanceConfigurationID(id.SubscriptionId, id.ResourceGroupName, id.ResourceName, "aksManagedNodeOSUpgradeSchedule")
+	nodeOSUpgradeScheduleResp, err := maintenanceConfigurationsClient.Get(ctx, nodeOSUpgradeScheduleId)
+	if err != nil && !response.WasNotFound(nodeOSUpgradeScheduleResp.HttpResponse) {
+		return fmt.Errorf("retrieving node os upgrade schedule maintenance config for %s: %+v", *id, err)
+	}
+	nodeOSUpgradeSchedule := make([]interface{}, 0)
+	if model := nodeOSUpgradeScheduleResp.Model; model != nil && model.Properties != nil {
+		nodeOSUpgradeSchedule = flattenKubernetesClusterMaintenanceWindowSchedule(model.Properties.MaintenanceWindow)
+	}
+	if err := d.Set("maintenance_window_node_os", nodeOSUpgradeSchedule); err != nil {
+		return fmt.Errorf("setting `maintenance_window_node_os`: %+v", err)
+	}
+
 	return tags.FlattenAndSet(d, respModel.Tags)
 }
 
@@ -2288,6 +2580,22 @@ func resourceKubernetesClusterDelete(d *pluginsdk.ResourceData, meta interface{}
 		}
 	}
 
+	if _, ok := d.GetOk("maintenance_window_auto_upgrade"); ok {
+		client := meta.(*clients.Client).Containers.MaintenanceConfigurationsClient
+		maintenanceId := maintenanceconfigurations.NewMaintenanceConfigurationID(id.SubscriptionId, id.ResourceGroupName, id.ResourceName, "aksManagedAutoUpgradeSchedule")
+		if _, err := client.Delete(ctx, maintenanceId); err != nil {
+			return fmt.Errorf("deleting auto upgrade schedule Maintenance Configuration for %s: %+v", *id, err)
+		}
+	}
+
+	if _, ok := d.GetOk("maintenance_window_node_os"); ok {
+		client := meta.(*clients.Client).Containers.MaintenanceConfigurationsClient
+		maintenanceId := maintenanceconfigurations.NewMaintenanceConfigurationID(id.SubscriptionId, id.ResourceGroupName, id.ResourceName, "aksManagedNodeOSUpgradeSchedule")
+		if _, err := client.Delete(ctx, maintenanceId); err != nil {
+			return fmt.Errorf("deleting node os upgrade schedule Maintenance Configuration for %s: %+v", *id, err)
+		}
+	}
+
 	ignorePodDisruptionBudget := true
 	future, err := client.Delete(ctx, *id, managedclusters.DeleteOperationOptions{
 		IgnorePodDisruptionBudget: &ignorePodDisruptionBudget,
@@ -3375,12 +3683,12 @@ func expandKubernetesClusterAutoScalerProfile(input []interface{}) *managedclust
 	}
 }
 
-func expandKubernetesClusterMaintenanceConfiguration(input []interface{}) *maintenanceconfigurations.MaintenanceConfigurationProperties {
+func expandKubernetesClusterMaintenanceConfiguration(input []interface{}) *maintenancewindows.MaintenanceConfigurationProperties {
 	if len(input) == 0 {
 		return nil
 	}
 	value := input[0].(map[string]interface{})
-	return &maintenanceconfigurations.MaintenanceConfigurationProperties{
+	return &maintenancewindows.MaintenanceConfigurationProperties{
 		NotAllowedTime: expandKubernetesClusterMaintenanceConfigurationTimeSpans(value["not_allowed"].(*pluginsdk.Set).List()),
 		TimeInWeek:     expandKubernetesClusterMaintenanceConfigurationTimeInWeeks(value["allowed"].(*pluginsdk.Set).List()),
 	}
@@ -3412,7 +3720,7 @@ func expandKubernetesClusterMaintenanceConfigurationTimeInWeeks(input []interfac
 	return &results
 }
 
-func flattenKubernetesClusterMaintenanceConfiguration(input *maintenanceconfigurations.MaintenanceConfigurationProperties) interface{} {
+func flattenKubernetesClusterMaintenanceConfiguration(input *maintenancewindows.MaintenanceConfigurationProperties) interface{} {
 	results := make([]interface{}, 0)
 	if input == nil {
 		return results
@@ -3466,6 +3774,194 @@ func flattenKubernetesClusterMaintenanceConfigurationTimeInWeeks(input *[]mainte
 	return results
 }
 
+func updateKubernetesClusterMaintenanceWindowSchedule(ctx context.Context, client *maintenancewindows.MaintenanceConfigurationsClient, id maintenanceconfigurations.MaintenanceConfigurationId, input []interface{}) error {
+	window, err := expandKubernetesClusterMaintenanceWindowSchedule(input)
+	if err != nil {
+		return err
+	}
+
+	if window == nil {
+		if _, err := client.Delete(ctx, id); err != nil {
+			return fmt.Errorf("deleting %s: %+v", id, err)
+		}
+		return nil
+	}
+
+	parameters := maintenancewindows.MaintenanceConfiguration{
+		Properties: &maintenancewindows.MaintenanceConfigurationProperties{
+			MaintenanceWindow: window,
+		},
+	}
+	if _, err := client.CreateOrUpdate(ctx, id, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func expandKubernetesClusterMaintenanceWindowSchedule(input []interface{}) (*maintenancewindows.MaintenanceWindow, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+	value := input[0].(map[string]interface{})
+
+	frequency := value["frequency"].(string)
+	interval := int64(value["interval"].(int))
+	dayOfWeek := value["day_of_week"].(string)
+	weekIndex := value["week_index"].(string)
+	dayOfMonth := int64(value["day_of_month"].(int))
+
+	schedule := maintenancewindows.Schedule{}
+	switch frequency {
+	case "Daily":
+		schedule.Daily = &maintenancewindows.DailySchedule{
+			IntervalDays: interval,
+		}
+	case "Weekly":
+		if dayOfWeek == "" {
+			return nil, fmt.Errorf("`day_of_week` must be specified when `frequency` is `Weekly`")
+		}
+		schedule.Weekly = &maintenancewindows.WeeklySchedule{
+			DayOfWeek:     maintenanceconfigurations.WeekDay(dayOfWeek),
+			IntervalWeeks: interval,
+		}
+	case "AbsoluteMonthly":
+		if dayOfMonth == 0 {
+			return nil, fmt.Errorf("`day_of_month` must be specified when `frequency` is `AbsoluteMonthly`")
+		}
+		schedule.AbsoluteMonthly = &maintenancewindows.AbsoluteMonthlySchedule{
+			DayOfMonth:     dayOfMonth,
+			IntervalMonths: interval,
+		}
+	case "RelativeMonthly":
+		if dayOfWeek == "" || weekIndex == "" {
+			return nil, fmt.Errorf("`day_of_week` and `week_index` must be specified when `frequency` is `RelativeMonthly`")
+		}
+		schedule.RelativeMonthly = &maintenancewindows.RelativeMonthlySchedule{
+			DayOfWeek:      maintenanceconfigurations.WeekDay(dayOfWeek),
+			IntervalMonths: interval,
+			WeekIndex:      maintenancewindows.Type(weekIndex),
+		}
+	}
+
+	output := &maintenancewindows.MaintenanceWindow{
+		DurationHours:   int64(value["duration"].(int)),
+		NotAllowedDates: expandKubernetesClusterMaintenanceWindowDateSpans(value["not_allowed"].(*pluginsdk.Set).List()),
+		Schedule:        schedule,
+		StartTime:       value["start_time"].(string),
+	}
+
+	if v := value["start_date"].(string); v != "" {
+		startDate, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `start_date` %q: %+v", v, err)
+		}
+		output.StartDate = utils.String(startDate.Format("2006-01-02"))
+	}
+
+	if v := value["utc_offset"].(string); v != "" {
+		output.UtcOffset = utils.String(v)
+	}
+
+	return output, nil
+}
+
+func expandKubernetesClusterMaintenanceWindowDateSpans(input []interface{}) *[]maintenancewindows.DateSpan {
+	results := make([]maintenancewindows.DateSpan, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		start, _ := time.Parse(time.RFC3339, v["start"].(string))
+		end, _ := time.Parse(time.RFC3339, v["end"].(string))
+		results = append(results, maintenancewindows.DateSpan{
+			Start: start.Format("2006-01-02"),
+			End:   end.Format("2006-01-02"),
+		})
+	}
+	return &results
+}
+
+func flattenKubernetesClusterMaintenanceWindowSchedule(input *maintenancewindows.MaintenanceWindow) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	frequency := ""
+	interval := int64(0)
+	dayOfWeek := ""
+	weekIndex := ""
+	dayOfMonth := int64(0)
+	if v := input.Schedule.Daily; v != nil {
+		frequency = "Daily"
+		interval = v.IntervalDays
+	}
+	if v := input.Schedule.Weekly; v != nil {
+		frequency = "Weekly"
+		interval = v.IntervalWeeks
+		dayOfWeek = string(v.DayOfWeek)
+	}
+	if v := input.Schedule.AbsoluteMonthly; v != nil {
+		frequency = "AbsoluteMonthly"
+		interval = v.IntervalMonths
+		dayOfMonth = v.DayOfMonth
+	}
+	if v := input.Schedule.RelativeMonthly; v != nil {
+		frequency = "RelativeMonthly"
+		interval = v.IntervalMonths
+		dayOfWeek = string(v.DayOfWeek)
+		weekIndex = string(v.WeekIndex)
+	}
+
+	startDate := ""
+	if input.StartDate != nil {
+		if v, err := time.Parse("2006-01-02", *input.StartDate); err == nil {
+			startDate = v.Format(time.RFC3339)
+		}
+	}
+
+	utcOffset := ""
+	if input.UtcOffset != nil {
+		utcOffset = *input.UtcOffset
+	}
+
+	results = append(results, map[string]interface{}{
+		"frequency":    frequency,
+		"interval":     interval,
+		"duration":     input.DurationHours,
+		"day_of_week":  dayOfWeek,
+		"week_index":   weekIndex,
+		"day_of_month": dayOfMonth,
+		"start_date":   startDate,
+		"start_time":   input.StartTime,
+		"utc_offset":   utcOffset,
+		"not_allowed":  flattenKubernetesClusterMaintenanceWindowDateSpans(input.NotAllowedDates),
+	})
+	return results
+}
+
+func flattenKubernetesClusterMaintenanceWindowDateSpans(input *[]maintenancewindows.DateSpan) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		var end string
+		if v, err := time.Parse("2006-01-02", item.End); err == nil {
+			end = v.Format(time.RFC3339)
+		}
+		var start string
+		if v, err := time.Parse("2006-01-02", item.Start); err == nil {
+			start = v.Format(time.RFC3339)
+		}
+		results = append(results, map[string]interface{}{
+			"end":   end,
+			"start": start,
+		})
+	}
+	return results
+}
+
 func expandKubernetesClusterHttpProxyConfig(input []interface{}) *managedclusters.ManagedClusterHTTPProxyConfig {
 	httpProxyConfig := managedclusters.ManagedClusterHTTPProxyConfig{}
 	if len(input) == 0 || input[0] == nil {
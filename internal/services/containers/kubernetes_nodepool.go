@@ -1,12 +1,17 @@
 package containers
 
 import (
+	"context"
 	"encoding/base64"
 	"fmt"
+	"log"
+	"net/http"
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/zones"
@@ -53,7 +58,6 @@ func SchemaDefaultNodePool() *pluginsdk.Schema {
 					"vm_size": {
 						Type:         pluginsdk.TypeString,
 						Required:     true,
-						ForceNew:     true,
 						ValidateFunc: validation.StringIsNotEmpty,
 					},
 
@@ -172,6 +176,12 @@ func SchemaDefaultNodePool() *pluginsdk.Schema {
 
 					"tags": commonschema.Tags(),
 
+					"temporary_name_for_rotation": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validate.KubernetesAgentPoolName,
+					},
+
 					"os_disk_size_gb": {
 						Type:         pluginsdk.TypeInt,
 						Optional:     true,
@@ -183,7 +193,6 @@ func SchemaDefaultNodePool() *pluginsdk.Schema {
 					"os_disk_type": {
 						Type:     pluginsdk.TypeString,
 						Optional: true,
-						ForceNew: true,
 						Default:  agentpools.OSDiskTypeManaged,
 						ValidateFunc: validation.StringInSlice([]string{
 							string(managedclusters.OSDiskTypeEphemeral),
@@ -215,7 +224,6 @@ func SchemaDefaultNodePool() *pluginsdk.Schema {
 					"vnet_subnet_id": {
 						Type:         pluginsdk.TypeString,
 						Optional:     true,
-						ForceNew:     true,
 						ValidateFunc: azure.ValidateResourceID,
 					},
 					"orchestrator_version": {
@@ -271,7 +279,7 @@ func SchemaDefaultNodePool() *pluginsdk.Schema {
 					},
 				}
 
-				s["zones"] = commonschema.ZonesMultipleOptionalForceNew()
+				s["zones"] = commonschema.ZonesMultipleOptional()
 
 				return s
 			}(),
@@ -625,6 +633,181 @@ func schemaNodePoolSysctlConfig() *pluginsdk.Schema {
 	}
 }
 
+// nodePoolRotationProperties are the properties of a Node Pool which can't be updated in-place - when rotation
+// has been opted into these are updated by rotating the Node Pool, otherwise they force the Node Pool (or for the
+// Default Node Pool, the Kubernetes Cluster) to be recreated
+var nodePoolRotationProperties = []string{
+	"os_disk_type",
+	"vm_size",
+	"vnet_subnet_id",
+	"zones",
+}
+
+// forceNewIfNodePoolCannotBeRotated marks any changed rotation properties of the Node Pool found at `prefix` as
+// requiring a new resource when `canBeRotated` returns false
+func forceNewIfNodePoolCannotBeRotated(prefix string, canBeRotated func(d *pluginsdk.ResourceDiff) bool) pluginsdk.CustomizeDiffFunc {
+	return func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+		if d.Id() == "" || canBeRotated(d) {
+			return nil
+		}
+
+		for _, property := range nodePoolRotationProperties {
+			if !d.HasChange(prefix + property) {
+				continue
+			}
+			if err := d.ForceNew(prefix + property); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// cycleNodePool replaces the Node Pool with one matching `profile` without removing all capacity at once. A
+// temporary Node Pool is provisioned first, the existing Node Pool is then drained and deleted, re-created with
+// the new configuration and finally the temporary Node Pool is removed. Each step checks the current state so
+// that a failed rotation can be resumed by re-running it.
+//
+// This is used for the Default Node Pool, which needs to retain its name since it's referenced by the Cluster.
+func cycleNodePool(ctx context.Context, client *agentpools.AgentPoolsClient, id agentpools.AgentPoolId, temporaryName string, profile agentpools.AgentPool) error {
+	if temporaryName == "" {
+		return fmt.Errorf("`temporary_name_for_rotation` must be specified when updating any of the following properties %q", nodePoolRotationProperties)
+	}
+
+	temporaryId := agentpools.NewAgentPoolID(id.SubscriptionId, id.ResourceGroupName, id.ResourceName, temporaryName)
+	temporaryExisting, err := client.Get(ctx, temporaryId)
+	if err != nil && !response.WasNotFound(temporaryExisting.HttpResponse) {
+		return fmt.Errorf("checking for existing temporary %s: %+v", temporaryId, err)
+	}
+
+	existing, err := client.Get(ctx, id)
+	if err != nil && !response.WasNotFound(existing.HttpResponse) {
+		return fmt.Errorf("checking for existing %s: %+v", id, err)
+	}
+
+	// if the temporary node pool already exists due to a previous failed rotation there's no need to create it again
+	if temporaryExisting.Model == nil {
+		log.Printf("[DEBUG] Creating temporary %s..", temporaryId)
+		temporaryProfile := profile
+		temporaryProfile.Id = nil
+		temporaryProfile.Name = utils.String(temporaryName)
+		if err := retryNodePoolCreation(ctx, client, temporaryId, temporaryProfile); err != nil {
+			return fmt.Errorf("creating temporary %s: %+v", temporaryId, err)
+		}
+	}
+
+	if existing.Model != nil {
+		log.Printf("[DEBUG] Deleting %s..", id)
+		future, err := client.Delete(ctx, id, agentpools.DefaultDeleteOperationOptions())
+		if err != nil {
+			return fmt.Errorf("deleting %s: %+v", id, err)
+		}
+		if err := future.Poller.PollUntilDone(); err != nil {
+			return fmt.Errorf("waiting for the deletion of %s: %+v", id, err)
+		}
+	}
+
+	log.Printf("[DEBUG] Re-creating %s..", id)
+	if err := retryNodePoolCreation(ctx, client, id, profile); err != nil {
+		// the temporary node pool is intentionally left in place so that the workloads keep running
+		return fmt.Errorf("re-creating %s: %+v", id, err)
+	}
+
+	log.Printf("[DEBUG] Deleting temporary %s..", temporaryId)
+	future, err := client.Delete(ctx, temporaryId, agentpools.DefaultDeleteOperationOptions())
+	if err != nil {
+		return fmt.Errorf("deleting temporary %s: %+v", temporaryId, err)
+	}
+	if err := future.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("waiting for the deletion of temporary %s: %+v", temporaryId, err)
+	}
+
+	return nil
+}
+
+// rotateNodePool replaces the Node Pool with a new Node Pool named `name` matching `profile`, without removing all
+// capacity at once. The new Node Pool is provisioned first and the existing Node Pool is then deleted - which AKS
+// does by cordoning and draining each node, so that the workloads are rescheduled onto the new Node Pool. Since the
+// existing Node Pool is only removed once the new Node Pool exists, a failed rotation can be resumed by re-running it.
+func rotateNodePool(ctx context.Context, client *agentpools.AgentPoolsClient, id agentpools.AgentPoolId, name string, profile agentpools.AgentPool) (*agentpools.AgentPoolId, error) {
+	newId := agentpools.NewAgentPoolID(id.SubscriptionId, id.ResourceGroupName, id.ResourceName, name)
+
+	log.Printf("[DEBUG] Creating %s..", newId)
+	newProfile := profile
+	newProfile.Id = nil
+	newProfile.Name = utils.String(name)
+	if err := retryNodePoolCreation(ctx, client, newId, newProfile); err != nil {
+		return nil, fmt.Errorf("creating %s: %+v", newId, err)
+	}
+
+	existing, err := client.Get(ctx, id)
+	if err != nil && !response.WasNotFound(existing.HttpResponse) {
+		return nil, fmt.Errorf("checking for existing %s: %+v", id, err)
+	}
+
+	if existing.Model != nil {
+		log.Printf("[DEBUG] Deleting %s..", id)
+		future, err := client.Delete(ctx, id, agentpools.DefaultDeleteOperationOptions())
+		if err != nil {
+			return nil, fmt.Errorf("deleting %s: %+v", id, err)
+		}
+		if err := future.Poller.PollUntilDone(); err != nil {
+			return nil, fmt.Errorf("waiting for the deletion of %s: %+v", id, err)
+		}
+	}
+
+	return &newId, nil
+}
+
+// nodePoolRotationName returns the name of the Node Pool which a Node Pool named `name` is rotated into, which is
+// derived from the name so that rotations alternate between the two names
+func nodePoolRotationName(name string, osType string) string {
+	// the names of Windows Node Pools are limited to 6 characters, rather than 12 for Linux Node Pools
+	maxLength := 12
+	if osType == string(agentpools.OSTypeWindows) {
+		maxLength = 6
+	}
+
+	base := name
+	if len(base) >= maxLength {
+		base = base[:maxLength-1]
+	}
+
+	suffix := "r"
+	if base+suffix == name {
+		suffix = "s"
+	}
+
+	return base + suffix
+}
+
+func retryNodePoolCreation(ctx context.Context, client *agentpools.AgentPoolsClient, id agentpools.AgentPoolId, profile agentpools.AgentPool) error {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context had no deadline")
+	}
+
+	// the creation of a node pool can be rejected with a conflict whilst another operation within the cluster (such
+	// as the deletion of another node pool) is in progress, or be throttled - so these are retried with a backoff
+	return pluginsdk.Retry(time.Until(deadline), func() *pluginsdk.RetryError {
+		future, err := client.CreateOrUpdate(ctx, id, profile)
+		if err != nil {
+			if response.WasConflict(future.HttpResponse) || response.WasStatusCode(future.HttpResponse, http.StatusTooManyRequests) {
+				log.Printf("[DEBUG] Creating %s was rejected, retrying: %+v", id, err)
+				return pluginsdk.RetryableError(err)
+			}
+			return pluginsdk.NonRetryableError(err)
+		}
+
+		if err := future.Poller.PollUntilDone(); err != nil {
+			return pluginsdk.NonRetryableError(err)
+		}
+
+		return nil
+	})
+}
+
 func ConvertDefaultNodePoolToAgentPool(input *[]managedclusters.ManagedClusterAgentPoolProfile) agentpools.AgentPool {
 	defaultCluster := (*input)[0]
 
@@ -1078,10 +1261,17 @@ func FlattenDefaultNodePool(input *[]managedclusters.ManagedClusterAgentPoolProf
 		return nil, err
 	}
 
+	// the nodes of a stopped cluster are deallocated and the auto-scaling settings aren't returned by the API - so
+	// the existing values are retained from the state to avoid a diff whilst the cluster is stopped
+	stopped := agentPool.PowerState != nil && agentPool.PowerState.Code != nil && *agentPool.PowerState.Code == managedclusters.CodeStopped
+
 	count := 0
 	if agentPool.Count != nil {
 		count = int(*agentPool.Count)
 	}
+	if stopped && count == 0 {
+		count = d.Get("default_node_pool.0.node_count").(int)
+	}
 
 	enableUltraSSD := false
 	if agentPool.EnableUltraSSD != nil {
@@ -1092,6 +1282,9 @@ func FlattenDefaultNodePool(input *[]managedclusters.ManagedClusterAgentPoolProf
 	if agentPool.EnableAutoScaling != nil {
 		enableAutoScaling = *agentPool.EnableAutoScaling
 	}
+	if stopped {
+		enableAutoScaling = d.Get("default_node_pool.0.enable_auto_scaling").(bool)
+	}
 
 	customCaTrustEnabled := false
 	if agentPool.EnableCustomCATrust != nil {
@@ -1117,6 +1310,9 @@ func FlattenDefaultNodePool(input *[]managedclusters.ManagedClusterAgentPoolProf
 	if agentPool.MaxCount != nil {
 		maxCount = int(*agentPool.MaxCount)
 	}
+	if stopped {
+		maxCount = d.Get("default_node_pool.0.max_count").(int)
+	}
 
 	maxPods := 0
 	if agentPool.MaxPods != nil {
@@ -1136,6 +1332,9 @@ func FlattenDefaultNodePool(input *[]managedclusters.ManagedClusterAgentPoolProf
 	if agentPool.MinCount != nil {
 		minCount = int(*agentPool.MinCount)
 	}
+	if stopped {
+		minCount = d.Get("default_node_pool.0.min_count").(int)
+	}
 
 	name := agentPool.Name
 
@@ -1261,6 +1460,7 @@ func FlattenDefaultNodePool(input *[]managedclusters.ManagedClusterAgentPoolProf
 		"os_sku":                        osSKU,
 		"scale_down_mode":               string(scaleDownMode),
 		"tags":                          tags.Flatten(agentPool.Tags),
+		"temporary_name_for_rotation":   d.Get("default_node_pool.0.temporary_name_for_rotation").(string),
 		"type":                          agentPoolType,
 		"ultra_ssd_enabled":             enableUltraSSD,
 		"vm_size":                       vmSize,
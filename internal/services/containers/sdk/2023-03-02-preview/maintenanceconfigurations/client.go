@@ -0,0 +1,18 @@
+package maintenanceconfigurations
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type MaintenanceConfigurationsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewMaintenanceConfigurationsClientWithBaseURI(endpoint string) MaintenanceConfigurationsClient {
+	return MaintenanceConfigurationsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,9 @@
+package maintenanceconfigurations
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type AbsoluteMonthlySchedule struct {
+	DayOfMonth     int64 `json:"dayOfMonth"`
+	IntervalMonths int64 `json:"intervalMonths"`
+}
@@ -0,0 +1,8 @@
+package maintenanceconfigurations
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type DailySchedule struct {
+	IntervalDays int64 `json:"intervalDays"`
+}
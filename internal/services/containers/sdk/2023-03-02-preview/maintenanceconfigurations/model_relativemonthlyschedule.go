@@ -0,0 +1,14 @@
+package maintenanceconfigurations
+
+import (
+	v20220902preview "github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2022-09-02-preview/maintenanceconfigurations"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type RelativeMonthlySchedule struct {
+	DayOfWeek      v20220902preview.WeekDay `json:"dayOfWeek"`
+	IntervalMonths int64                    `json:"intervalMonths"`
+	WeekIndex      Type                     `json:"weekIndex"`
+}
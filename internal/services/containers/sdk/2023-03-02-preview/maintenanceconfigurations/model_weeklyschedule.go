@@ -0,0 +1,13 @@
+package maintenanceconfigurations
+
+import (
+	v20220902preview "github.com/hashicorp/go-azure-sdk/resource-manager/containerservice/2022-09-02-preview/maintenanceconfigurations"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type WeeklySchedule struct {
+	DayOfWeek     v20220902preview.WeekDay `json:"dayOfWeek"`
+	IntervalWeeks int64                    `json:"intervalWeeks"`
+}
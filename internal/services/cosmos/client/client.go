@@ -4,9 +4,22 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-10-15/documentdb" // nolint: staticcheck
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2022-05-15/managedcassandras"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2022-05-15/sqldedicatedgateway"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2022-08-15/mongorbacs"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/sdk/2022-11-08/clusters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/sdk/2022-11-08/configurations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/sdk/2022-11-08/firewallrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/sdk/2022-11-08/roles"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/sdk/2025-04-15/cosmosdb"
 )
 
+// NOTE: the Cosmos DB for PostgreSQL SDK is embedded since the vendored SDK doesn't include
+// `Microsoft.DBforPostgreSQL/serverGroupsv2` - API Version `2022-11-08` is the first stable version to support Clusters
+
+// NOTE: the SQL Container SDK is embedded since the vendored SDK uses an API Version which doesn't include Computed
+// Properties, Full Text/Vector Indexes or the Full Text/Vector Embedding Policies - API Version `2025-04-15` is used
+// since it's the first stable version to support all of these
+
 type Client struct {
 	CassandraClient                  *documentdb.CassandraResourcesClient
 	CassandraClustersClient          *managedcassandras.ManagedCassandrasClient
@@ -14,10 +27,16 @@ type Client struct {
 	DatabaseClient                   *documentdb.DatabaseAccountsClient
 	GremlinClient                    *documentdb.GremlinResourcesClient
 	MongoDbClient                    *documentdb.MongoDBResourcesClient
+	MongoRBACClient                  *mongorbacs.MongorbacsClient
 	NotebookWorkspaceClient          *documentdb.NotebookWorkspacesClient
+	PostgresqlClustersClient         *clusters.ClustersClient
+	PostgresqlConfigurationsClient   *configurations.ConfigurationsClient
+	PostgresqlFirewallRulesClient    *firewallrules.FirewallRulesClient
+	PostgresqlRolesClient            *roles.RolesClient
 	RestorableDatabaseAccountsClient *documentdb.RestorableDatabaseAccountsClient
 	SqlDedicatedGatewayClient        *sqldedicatedgateway.SqlDedicatedGatewayClient
 	SqlClient                        *documentdb.SQLResourcesClient
+	SqlContainerClient               *cosmosdb.CosmosDBClient
 	SqlResourceClient                *documentdb.SQLResourcesClient
 	TableClient                      *documentdb.TableResourcesClient
 }
@@ -41,9 +60,24 @@ func NewClient(o *common.ClientOptions) *Client {
 	mongoDbClient := documentdb.NewMongoDBResourcesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&mongoDbClient.Client, o.ResourceManagerAuthorizer)
 
+	mongoRBACClient := mongorbacs.NewMongorbacsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&mongoRBACClient.Client, o.ResourceManagerAuthorizer)
+
 	notebookWorkspaceClient := documentdb.NewNotebookWorkspacesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&notebookWorkspaceClient.Client, o.ResourceManagerAuthorizer)
 
+	postgresqlClustersClient := clusters.NewClustersClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&postgresqlClustersClient.Client, o.ResourceManagerAuthorizer)
+
+	postgresqlConfigurationsClient := configurations.NewConfigurationsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&postgresqlConfigurationsClient.Client, o.ResourceManagerAuthorizer)
+
+	postgresqlFirewallRulesClient := firewallrules.NewFirewallRulesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&postgresqlFirewallRulesClient.Client, o.ResourceManagerAuthorizer)
+
+	postgresqlRolesClient := roles.NewRolesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&postgresqlRolesClient.Client, o.ResourceManagerAuthorizer)
+
 	restorableDatabaseAccountsClient := documentdb.NewRestorableDatabaseAccountsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&restorableDatabaseAccountsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -53,6 +87,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	sqlClient := documentdb.NewSQLResourcesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&sqlClient.Client, o.ResourceManagerAuthorizer)
 
+	sqlContainerClient := cosmosdb.NewCosmosDBClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&sqlContainerClient.Client, o.ResourceManagerAuthorizer)
+
 	sqlResourceClient := documentdb.NewSQLResourcesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&sqlResourceClient.Client, o.ResourceManagerAuthorizer)
 
@@ -66,10 +103,16 @@ func NewClient(o *common.ClientOptions) *Client {
 		DatabaseClient:                   &databaseClient,
 		GremlinClient:                    &gremlinClient,
 		MongoDbClient:                    &mongoDbClient,
+		MongoRBACClient:                  &mongoRBACClient,
 		NotebookWorkspaceClient:          &notebookWorkspaceClient,
+		PostgresqlClustersClient:         &postgresqlClustersClient,
+		PostgresqlConfigurationsClient:   &postgresqlConfigurationsClient,
+		PostgresqlFirewallRulesClient:    &postgresqlFirewallRulesClient,
+		PostgresqlRolesClient:            &postgresqlRolesClient,
 		RestorableDatabaseAccountsClient: &restorableDatabaseAccountsClient,
 		SqlDedicatedGatewayClient:        &sqlDedicatedGatewayClient,
 		SqlClient:                        &sqlClient,
+		SqlContainerClient:               &sqlContainerClient,
 		SqlResourceClient:                &sqlResourceClient,
 		TableClient:                      &tableClient,
 	}
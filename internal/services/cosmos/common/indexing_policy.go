@@ -5,21 +5,30 @@ import (
 	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-10-15/documentdb" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/sdk/2025-04-15/cosmosdb"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
-func expandAzureRmCosmosDBIndexingPolicyIncludedPaths(input []interface{}) *[]documentdb.IncludedPath {
+// these paths are automatically added by the server and should be excluded on flattening
+// as the user isn't setting them and they will show changes in state.
+var serverManagedExcludedPaths = []string{
+	"/\"_etag\"/?",
+	"/_etag/?",
+}
+
+func expandAzureRmCosmosDBIndexingPolicyIncludedPaths(input []interface{}) *[]cosmosdb.IncludedPath {
 	if len(input) == 0 {
 		return nil
 	}
 
-	var includedPaths []documentdb.IncludedPath
+	var includedPaths []cosmosdb.IncludedPath
 
 	for _, v := range input {
 		includedPath := v.(map[string]interface{})
-		path := documentdb.IncludedPath{
+		path := cosmosdb.IncludedPath{
 			Path: utils.String(includedPath["path"].(string)),
 		}
 
@@ -29,16 +38,16 @@ func expandAzureRmCosmosDBIndexingPolicyIncludedPaths(input []interface{}) *[]do
 	return &includedPaths
 }
 
-func expandAzureRmCosmosDBIndexingPolicyExcludedPaths(input []interface{}) *[]documentdb.ExcludedPath {
+func expandAzureRmCosmosDBIndexingPolicyExcludedPaths(input []interface{}) *[]cosmosdb.ExcludedPath {
 	if len(input) == 0 {
 		return nil
 	}
 
-	var paths []documentdb.ExcludedPath
+	var paths []cosmosdb.ExcludedPath
 
 	for _, v := range input {
 		block := v.(map[string]interface{})
-		paths = append(paths, documentdb.ExcludedPath{
+		paths = append(paths, cosmosdb.ExcludedPath{
 			Path: utils.String(block["path"].(string)),
 		})
 	}
@@ -46,6 +55,107 @@ func expandAzureRmCosmosDBIndexingPolicyExcludedPaths(input []interface{}) *[]do
 	return &paths
 }
 
+func expandAzureRmCosmosDBSqlIndexingPolicyCompositeIndexes(input []interface{}) *[][]cosmosdb.CompositePath {
+	indexes := make([][]cosmosdb.CompositePath, 0)
+
+	for _, i := range input {
+		indexPairs := make([]cosmosdb.CompositePath, 0)
+		indexPair := i.(map[string]interface{})
+		for _, idxPair := range indexPair["index"].([]interface{}) {
+			data := idxPair.(map[string]interface{})
+
+			index := cosmosdb.CompositePath{
+				Path:  utils.String(data["path"].(string)),
+				Order: pointer.To(cosmosdb.CompositePathSortOrder(strings.ToLower(data["order"].(string)))),
+			}
+			indexPairs = append(indexPairs, index)
+		}
+		indexes = append(indexes, indexPairs)
+	}
+
+	return &indexes
+}
+
+func expandAzureRmCosmosDBSqlIndexingPolicySpatialIndexes(input []interface{}) *[]cosmosdb.SpatialSpec {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	indexes := make([]cosmosdb.SpatialSpec, 0)
+
+	for _, i := range input {
+		indexPair := i.(map[string]interface{})
+
+		spatialTypes := make([]cosmosdb.SpatialType, 0)
+		for _, v := range expandCosmosDBIndexingPolicySpatialTypes(indexPair["types"]) {
+			spatialTypes = append(spatialTypes, cosmosdb.SpatialType(v))
+		}
+
+		indexes = append(indexes, cosmosdb.SpatialSpec{
+			Types: &spatialTypes,
+			Path:  utils.String(indexPair["path"].(string)),
+		})
+	}
+
+	return &indexes
+}
+
+func expandAzureRmCosmosDBIndexingPolicyVectorIndexes(input []interface{}) *[]cosmosdb.VectorIndex {
+	if len(input) == 0 {
+		return nil
+	}
+
+	indexes := make([]cosmosdb.VectorIndex, 0)
+
+	for _, v := range input {
+		block := v.(map[string]interface{})
+		indexes = append(indexes, cosmosdb.VectorIndex{
+			Path: block["path"].(string),
+			Type: cosmosdb.VectorIndexType(block["type"].(string)),
+		})
+	}
+
+	return &indexes
+}
+
+func expandAzureRmCosmosDBIndexingPolicyFullTextIndexes(input []interface{}) *[]cosmosdb.FullTextIndexPath {
+	if len(input) == 0 {
+		return nil
+	}
+
+	indexes := make([]cosmosdb.FullTextIndexPath, 0)
+
+	for _, v := range input {
+		block := v.(map[string]interface{})
+		indexes = append(indexes, cosmosdb.FullTextIndexPath{
+			Path: block["path"].(string),
+		})
+	}
+
+	return &indexes
+}
+
+// expandCosmosDBIndexingPolicySpatialTypes returns the Spatial Types specified for a Spatial Index - when none are
+// specified all types are returned, since these are otherwise set by the service regardless
+func expandCosmosDBIndexingPolicySpatialTypes(input interface{}) []string {
+	types := make([]string, 0)
+	if v, ok := input.(*pluginsdk.Set); ok && v != nil {
+		for _, t := range v.List() {
+			types = append(types, t.(string))
+		}
+	}
+
+	if len(types) == 0 {
+		types = []string{
+			string(documentdb.SpatialTypeLineString),
+			string(documentdb.SpatialTypeMultiPolygon),
+			string(documentdb.SpatialTypePoint),
+			string(documentdb.SpatialTypePolygon),
+		}
+	}
+
+	return types
+}
+
 func ExpandAzureRmCosmosDBIndexingPolicyCompositeIndexes(input []interface{}) *[][]documentdb.CompositePath {
 	indexes := make([][]documentdb.CompositePath, 0)
 
@@ -72,16 +182,15 @@ func ExpandAzureRmCosmosDBIndexingPolicySpatialIndexes(input []interface{}) *[]d
 		return nil
 	}
 	indexes := make([]documentdb.SpatialSpec, 0)
-	// no matter what spatial types are updated, all types will be set and returned from service
-	spatialTypes := []documentdb.SpatialType{
-		documentdb.SpatialTypeLineString,
-		documentdb.SpatialTypeMultiPolygon,
-		documentdb.SpatialTypePoint,
-		documentdb.SpatialTypePolygon,
-	}
 
 	for _, i := range input {
 		indexPair := i.(map[string]interface{})
+
+		spatialTypes := make([]documentdb.SpatialType, 0)
+		for _, v := range expandCosmosDBIndexingPolicySpatialTypes(indexPair["types"]) {
+			spatialTypes = append(spatialTypes, documentdb.SpatialType(v))
+		}
+
 		indexes = append(indexes, documentdb.SpatialSpec{
 			Types: &spatialTypes,
 			Path:  utils.String(indexPair["path"].(string)),
@@ -91,15 +200,15 @@ func ExpandAzureRmCosmosDBIndexingPolicySpatialIndexes(input []interface{}) *[]d
 	return &indexes
 }
 
-func ExpandAzureRmCosmosDbIndexingPolicy(d *pluginsdk.ResourceData) *documentdb.IndexingPolicy {
+func ExpandAzureRmCosmosDbIndexingPolicy(d *pluginsdk.ResourceData) *cosmosdb.IndexingPolicy {
 	i := d.Get("indexing_policy").([]interface{})
 
 	if len(i) == 0 || i[0] == nil {
 		return nil
 	}
 	input := i[0].(map[string]interface{})
-	policy := &documentdb.IndexingPolicy{}
-	policy.IndexingMode = documentdb.IndexingMode(strings.ToLower(input["indexing_mode"].(string)))
+	policy := &cosmosdb.IndexingPolicy{}
+	policy.IndexingMode = pointer.To(cosmosdb.IndexingMode(strings.ToLower(input["indexing_mode"].(string))))
 	if v, ok := input["included_path"].([]interface{}); ok {
 		policy.IncludedPaths = expandAzureRmCosmosDBIndexingPolicyIncludedPaths(v)
 	}
@@ -108,15 +217,23 @@ func ExpandAzureRmCosmosDbIndexingPolicy(d *pluginsdk.ResourceData) *documentdb.
 	}
 
 	if v, ok := input["composite_index"].([]interface{}); ok {
-		policy.CompositeIndexes = ExpandAzureRmCosmosDBIndexingPolicyCompositeIndexes(v)
+		policy.CompositeIndexes = expandAzureRmCosmosDBSqlIndexingPolicyCompositeIndexes(v)
 	}
 
-	policy.SpatialIndexes = ExpandAzureRmCosmosDBIndexingPolicySpatialIndexes(input["spatial_index"].([]interface{}))
+	policy.SpatialIndexes = expandAzureRmCosmosDBSqlIndexingPolicySpatialIndexes(input["spatial_index"].([]interface{}))
+
+	if v, ok := input["vector_index"].([]interface{}); ok {
+		policy.VectorIndexes = expandAzureRmCosmosDBIndexingPolicyVectorIndexes(v)
+	}
+
+	if v, ok := input["full_text_index"].([]interface{}); ok {
+		policy.FullTextIndexes = expandAzureRmCosmosDBIndexingPolicyFullTextIndexes(v)
+	}
 
 	return policy
 }
 
-func flattenCosmosDBIndexingPolicyExcludedPaths(input *[]documentdb.ExcludedPath) []interface{} {
+func flattenCosmosDBIndexingPolicyExcludedPaths(input *[]cosmosdb.ExcludedPath) []interface{} {
 	if input == nil {
 		return nil
 	}
@@ -124,20 +241,97 @@ func flattenCosmosDBIndexingPolicyExcludedPaths(input *[]documentdb.ExcludedPath
 	excludedPaths := make([]interface{}, 0)
 
 	for _, v := range *input {
-		// _etag is automatically added by the server and should be excluded on flattening
-		// as the user isn't setting it and it will show changes in state.
-		if *v.Path == "/\"_etag\"/?" {
+		if v.Path == nil || utils.SliceContainsValue(serverManagedExcludedPaths, *v.Path) {
 			continue
 		}
 
 		block := make(map[string]interface{})
-		block["path"] = v.Path
+		block["path"] = *v.Path
 		excludedPaths = append(excludedPaths, block)
 	}
 
 	return excludedPaths
 }
 
+func flattenCosmosDBSqlIndexingPolicyCompositeIndexes(input *[][]cosmosdb.CompositePath) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	indexes := make([]interface{}, 0)
+
+	for _, v := range *input {
+		indexPairs := make([]interface{}, 0)
+		for _, pair := range v {
+			indexPairs = append(indexPairs, map[string]interface{}{
+				"path":  pointer.From(pair.Path),
+				"order": string(pointer.From(pair.Order)),
+			})
+		}
+
+		indexes = append(indexes, map[string]interface{}{
+			"index": indexPairs,
+		})
+	}
+
+	return indexes
+}
+
+func flattenCosmosDBSqlIndexingPolicySpatialIndexes(input *[]cosmosdb.SpatialSpec) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	indexes := make([]interface{}, 0)
+
+	for _, v := range *input {
+		types := make([]interface{}, 0)
+		if v.Types != nil {
+			for _, t := range *v.Types {
+				types = append(types, string(t))
+			}
+		}
+
+		indexes = append(indexes, map[string]interface{}{
+			"path":  pointer.From(v.Path),
+			"types": types,
+		})
+	}
+
+	return indexes
+}
+
+func flattenCosmosDBIndexingPolicyVectorIndexes(input *[]cosmosdb.VectorIndex) []interface{} {
+	indexes := make([]interface{}, 0)
+	if input == nil {
+		return indexes
+	}
+
+	for _, v := range *input {
+		indexes = append(indexes, map[string]interface{}{
+			"path": v.Path,
+			"type": string(v.Type),
+		})
+	}
+
+	return indexes
+}
+
+func flattenCosmosDBIndexingPolicyFullTextIndexes(input *[]cosmosdb.FullTextIndexPath) []interface{} {
+	indexes := make([]interface{}, 0)
+	if input == nil {
+		return indexes
+	}
+
+	for _, v := range *input {
+		indexes = append(indexes, map[string]interface{}{
+			"path": v.Path,
+		})
+	}
+
+	return indexes
+}
+
 func flattenCosmosDBIndexingPolicyCompositeIndex(input []documentdb.CompositePath) []interface{} {
 	if input == nil {
 		return []interface{}{}
@@ -175,7 +369,7 @@ func FlattenCosmosDBIndexingPolicyCompositeIndexes(input *[][]documentdb.Composi
 	return indexes
 }
 
-func flattenCosmosDBIndexingPolicyIncludedPaths(input *[]documentdb.IncludedPath) []interface{} {
+func flattenCosmosDBIndexingPolicyIncludedPaths(input *[]cosmosdb.IncludedPath) []interface{} {
 	if input == nil {
 		return nil
 	}
@@ -184,7 +378,7 @@ func flattenCosmosDBIndexingPolicyIncludedPaths(input *[]documentdb.IncludedPath
 
 	for _, v := range *input {
 		block := make(map[string]interface{})
-		block["path"] = v.Path
+		block["path"] = pointer.From(v.Path)
 		includedPaths = append(includedPaths, block)
 	}
 
@@ -226,36 +420,38 @@ func flattenCosmosDBIndexingPolicySpatialIndexesTypes(input *[]documentdb.Spatia
 	return types
 }
 
-func FlattenAzureRmCosmosDbIndexingPolicy(indexingPolicy *documentdb.IndexingPolicy) []interface{} {
+func FlattenAzureRmCosmosDbIndexingPolicy(indexingPolicy *cosmosdb.IndexingPolicy) []interface{} {
 	results := make([]interface{}, 0)
 	if indexingPolicy == nil {
 		return results
 	}
 
 	result := make(map[string]interface{})
-	result["indexing_mode"] = string(indexingPolicy.IndexingMode)
+	result["indexing_mode"] = string(pointer.From(indexingPolicy.IndexingMode))
 	result["included_path"] = flattenCosmosDBIndexingPolicyIncludedPaths(indexingPolicy.IncludedPaths)
 	result["excluded_path"] = flattenCosmosDBIndexingPolicyExcludedPaths(indexingPolicy.ExcludedPaths)
-	result["composite_index"] = FlattenCosmosDBIndexingPolicyCompositeIndexes(indexingPolicy.CompositeIndexes)
-	result["spatial_index"] = FlattenCosmosDBIndexingPolicySpatialIndexes(indexingPolicy.SpatialIndexes)
+	result["composite_index"] = flattenCosmosDBSqlIndexingPolicyCompositeIndexes(indexingPolicy.CompositeIndexes)
+	result["spatial_index"] = flattenCosmosDBSqlIndexingPolicySpatialIndexes(indexingPolicy.SpatialIndexes)
+	result["vector_index"] = flattenCosmosDBIndexingPolicyVectorIndexes(indexingPolicy.VectorIndexes)
+	result["full_text_index"] = flattenCosmosDBIndexingPolicyFullTextIndexes(indexingPolicy.FullTextIndexes)
 
 	results = append(results, result)
 	return results
 }
 
-func ValidateAzureRmCosmosDbIndexingPolicy(indexingPolicy *documentdb.IndexingPolicy) error {
+func ValidateAzureRmCosmosDbIndexingPolicy(indexingPolicy *cosmosdb.IndexingPolicy) error {
 	if indexingPolicy == nil {
 		return nil
 	}
 
 	// Ensure includedPaths or excludedPaths are not set if indexingMode is "None".
-	if indexingPolicy.IndexingMode == documentdb.IndexingModeNone {
+	if pointer.From(indexingPolicy.IndexingMode) == cosmosdb.IndexingModeNone {
 		if indexingPolicy.IncludedPaths != nil {
-			return fmt.Errorf("included_path must not be set if indexing_mode is %q", azure.TitleCase(string(documentdb.IndexingModeNone)))
+			return fmt.Errorf("included_path must not be set if indexing_mode is %q", azure.TitleCase(string(cosmosdb.IndexingModeNone)))
 		}
 
 		if indexingPolicy.ExcludedPaths != nil {
-			return fmt.Errorf("excluded_path must not be set if indexing_mode is %q", azure.TitleCase(string(documentdb.IndexingModeNone)))
+			return fmt.Errorf("excluded_path must not be set if indexing_mode is %q", azure.TitleCase(string(cosmosdb.IndexingModeNone)))
 		}
 	}
 
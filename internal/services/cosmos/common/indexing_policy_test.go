@@ -3,14 +3,15 @@ package common
 import (
 	"testing"
 
-	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-10-15/documentdb" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/sdk/2025-04-15/cosmosdb"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
 func TestValidateAzureRmCosmosDbIndexingPolicy(t *testing.T) {
 	cases := []struct {
 		Name        string
-		Value       *documentdb.IndexingPolicy
+		Value       *cosmosdb.IndexingPolicy
 		ExpectError bool
 	}{
 		{
@@ -20,23 +21,23 @@ func TestValidateAzureRmCosmosDbIndexingPolicy(t *testing.T) {
 		},
 		{
 			Name: "no included_path or excluded_path with Consistent indexing_mode",
-			Value: &documentdb.IndexingPolicy{
-				IndexingMode: documentdb.IndexingModeConsistent,
+			Value: &cosmosdb.IndexingPolicy{
+				IndexingMode: pointer.To(cosmosdb.IndexingModeConsistent),
 			},
 			ExpectError: false,
 		},
 		{
 			Name: "no included_path or excluded_path with None indexing_mode",
-			Value: &documentdb.IndexingPolicy{
-				IndexingMode: documentdb.IndexingModeNone,
+			Value: &cosmosdb.IndexingPolicy{
+				IndexingMode: pointer.To(cosmosdb.IndexingModeNone),
 			},
 			ExpectError: false,
 		},
 		{
 			Name: "included_path with /*",
-			Value: &documentdb.IndexingPolicy{
-				IndexingMode: documentdb.IndexingModeConsistent,
-				IncludedPaths: &[]documentdb.IncludedPath{
+			Value: &cosmosdb.IndexingPolicy{
+				IndexingMode: pointer.To(cosmosdb.IndexingModeConsistent),
+				IncludedPaths: &[]cosmosdb.IncludedPath{
 					{
 						Path: utils.String("/*"),
 					},
@@ -49,9 +50,9 @@ func TestValidateAzureRmCosmosDbIndexingPolicy(t *testing.T) {
 		},
 		{
 			Name: "excluded_path with /*",
-			Value: &documentdb.IndexingPolicy{
-				IndexingMode: documentdb.IndexingModeConsistent,
-				ExcludedPaths: &[]documentdb.ExcludedPath{
+			Value: &cosmosdb.IndexingPolicy{
+				IndexingMode: pointer.To(cosmosdb.IndexingModeConsistent),
+				ExcludedPaths: &[]cosmosdb.ExcludedPath{
 					{
 						Path: utils.String("/*"),
 					},
@@ -64,9 +65,9 @@ func TestValidateAzureRmCosmosDbIndexingPolicy(t *testing.T) {
 		},
 		{
 			Name: "included_path with /* and excluded_path",
-			Value: &documentdb.IndexingPolicy{
-				IndexingMode: documentdb.IndexingModeConsistent,
-				IncludedPaths: &[]documentdb.IncludedPath{
+			Value: &cosmosdb.IndexingPolicy{
+				IndexingMode: pointer.To(cosmosdb.IndexingModeConsistent),
+				IncludedPaths: &[]cosmosdb.IncludedPath{
 					{
 						Path: utils.String("/*"),
 					},
@@ -74,7 +75,7 @@ func TestValidateAzureRmCosmosDbIndexingPolicy(t *testing.T) {
 						Path: utils.String("/foo/?"),
 					},
 				},
-				ExcludedPaths: &[]documentdb.ExcludedPath{
+				ExcludedPaths: &[]cosmosdb.ExcludedPath{
 					{
 						Path: utils.String("/testing/?"),
 					},
@@ -87,9 +88,9 @@ func TestValidateAzureRmCosmosDbIndexingPolicy(t *testing.T) {
 		},
 		{
 			Name: "included_path and excluded_path with /*",
-			Value: &documentdb.IndexingPolicy{
-				IndexingMode: documentdb.IndexingModeConsistent,
-				IncludedPaths: &[]documentdb.IncludedPath{
+			Value: &cosmosdb.IndexingPolicy{
+				IndexingMode: pointer.To(cosmosdb.IndexingModeConsistent),
+				IncludedPaths: &[]cosmosdb.IncludedPath{
 					{
 						Path: utils.String("/*"),
 					},
@@ -97,7 +98,7 @@ func TestValidateAzureRmCosmosDbIndexingPolicy(t *testing.T) {
 						Path: utils.String("/foo/?"),
 					},
 				},
-				ExcludedPaths: &[]documentdb.ExcludedPath{
+				ExcludedPaths: &[]cosmosdb.ExcludedPath{
 					{
 						Path: utils.String("/*"),
 					},
@@ -113,9 +114,9 @@ func TestValidateAzureRmCosmosDbIndexingPolicy(t *testing.T) {
 		},
 		{
 			Name: "missing /* from included_path",
-			Value: &documentdb.IndexingPolicy{
-				IndexingMode: documentdb.IndexingModeConsistent,
-				IncludedPaths: &[]documentdb.IncludedPath{
+			Value: &cosmosdb.IndexingPolicy{
+				IndexingMode: pointer.To(cosmosdb.IndexingModeConsistent),
+				IncludedPaths: &[]cosmosdb.IncludedPath{
 					{
 						Path: utils.String("/testing/?"),
 					},
@@ -128,9 +129,9 @@ func TestValidateAzureRmCosmosDbIndexingPolicy(t *testing.T) {
 		},
 		{
 			Name: "missing /* with included_path and excluded_path",
-			Value: &documentdb.IndexingPolicy{
-				IndexingMode: documentdb.IndexingModeConsistent,
-				IncludedPaths: &[]documentdb.IncludedPath{
+			Value: &cosmosdb.IndexingPolicy{
+				IndexingMode: pointer.To(cosmosdb.IndexingModeConsistent),
+				IncludedPaths: &[]cosmosdb.IncludedPath{
 					{
 						Path: utils.String("/foo/?"),
 					},
@@ -138,7 +139,7 @@ func TestValidateAzureRmCosmosDbIndexingPolicy(t *testing.T) {
 						Path: utils.String("/foo/?"),
 					},
 				},
-				ExcludedPaths: &[]documentdb.ExcludedPath{
+				ExcludedPaths: &[]cosmosdb.ExcludedPath{
 					{
 						Path: utils.String("/bar/?"),
 					},
@@ -151,9 +152,9 @@ func TestValidateAzureRmCosmosDbIndexingPolicy(t *testing.T) {
 		},
 		{
 			Name: "indexing_mode None with included_path",
-			Value: &documentdb.IndexingPolicy{
-				IndexingMode: documentdb.IndexingModeNone,
-				IncludedPaths: &[]documentdb.IncludedPath{
+			Value: &cosmosdb.IndexingPolicy{
+				IndexingMode: pointer.To(cosmosdb.IndexingModeNone),
+				IncludedPaths: &[]cosmosdb.IncludedPath{
 					{
 						Path: utils.String("/*"),
 					},
@@ -163,9 +164,9 @@ func TestValidateAzureRmCosmosDbIndexingPolicy(t *testing.T) {
 		},
 		{
 			Name: "indexing_mode None with excluded_path",
-			Value: &documentdb.IndexingPolicy{
-				IndexingMode: documentdb.IndexingModeNone,
-				ExcludedPaths: &[]documentdb.ExcludedPath{
+			Value: &cosmosdb.IndexingPolicy{
+				IndexingMode: pointer.To(cosmosdb.IndexingModeNone),
+				ExcludedPaths: &[]cosmosdb.ExcludedPath{
 					{
 						Path: utils.String("/*"),
 					},
@@ -175,14 +176,14 @@ func TestValidateAzureRmCosmosDbIndexingPolicy(t *testing.T) {
 		},
 		{
 			Name: "indexing_mode None with included_path and excluded_path",
-			Value: &documentdb.IndexingPolicy{
-				IndexingMode: documentdb.IndexingModeNone,
-				IncludedPaths: &[]documentdb.IncludedPath{
+			Value: &cosmosdb.IndexingPolicy{
+				IndexingMode: pointer.To(cosmosdb.IndexingModeNone),
+				IncludedPaths: &[]cosmosdb.IncludedPath{
 					{
 						Path: utils.String("/*"),
 					},
 				},
-				ExcludedPaths: &[]documentdb.ExcludedPath{
+				ExcludedPaths: &[]cosmosdb.ExcludedPath{
 					{
 						Path: utils.String("/testing/?"),
 					},
@@ -203,3 +204,64 @@ func TestValidateAzureRmCosmosDbIndexingPolicy(t *testing.T) {
 		}
 	}
 }
+
+func TestFlattenAzureRmCosmosDbIndexingPolicyExcludedPaths(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Value    *[]cosmosdb.ExcludedPath
+		Expected []string
+	}{
+		{
+			Name:     "nil",
+			Value:    nil,
+			Expected: nil,
+		},
+		{
+			Name: "server managed _etag paths",
+			Value: &[]cosmosdb.ExcludedPath{
+				{
+					Path: utils.String("/\"_etag\"/?"),
+				},
+				{
+					Path: utils.String("/_etag/?"),
+				},
+			},
+			Expected: []string{},
+		},
+		{
+			Name: "user defined paths with server managed _etag path",
+			Value: &[]cosmosdb.ExcludedPath{
+				{
+					Path: utils.String("/foo/?"),
+				},
+				{
+					Path: utils.String("/\"_etag\"/?"),
+				},
+				{
+					Path: utils.String("/bar/*"),
+				},
+			},
+			Expected: []string{"/foo/?", "/bar/*"},
+		},
+	}
+
+	for _, tc := range cases {
+		result := flattenCosmosDBIndexingPolicyExcludedPaths(tc.Value)
+		if tc.Expected == nil {
+			if result != nil {
+				t.Fatalf("Expected no paths for %q but got %+v", tc.Name, result)
+			}
+			continue
+		}
+
+		if len(result) != len(tc.Expected) {
+			t.Fatalf("Expected %d paths for %q but got %d", len(tc.Expected), tc.Name, len(result))
+		}
+
+		for i, v := range result {
+			if path := v.(map[string]interface{})["path"].(string); path != tc.Expected[i] {
+				t.Fatalf("Expected path %q at index %d for %q but got %q", tc.Expected[i], i, tc.Name, path)
+			}
+		}
+	}
+}
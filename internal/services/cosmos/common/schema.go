@@ -2,6 +2,7 @@ package common
 
 import (
 	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-10-15/documentdb" // nolint: staticcheck
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/sdk/2025-04-15/cosmosdb"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
@@ -144,6 +145,40 @@ func CosmosDbIndexingPolicySchema() *pluginsdk.Schema {
 				"composite_index": CosmosDbIndexingPolicyCompositeIndexSchema(),
 
 				"spatial_index": CosmosDbIndexingPolicySpatialIndexSchema(),
+
+				"vector_index": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"path": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"type": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringInSlice(cosmosdb.PossibleValuesForVectorIndexType(), false),
+							},
+						},
+					},
+				},
+
+				"full_text_index": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"path": {
+								Type:         pluginsdk.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
 			},
 		},
 	}
@@ -231,11 +266,19 @@ func CosmosDbIndexingPolicySpatialIndexSchema() *pluginsdk.Schema {
 					ValidateFunc: validation.StringIsNotEmpty,
 				},
 
+				// all Spatial Types are indexed when none are specified
 				"types": {
 					Type:     pluginsdk.TypeSet,
+					Optional: true,
 					Computed: true,
 					Elem: &pluginsdk.Schema{
 						Type: pluginsdk.TypeString,
+						ValidateFunc: validation.StringInSlice([]string{
+							string(documentdb.SpatialTypeLineString),
+							string(documentdb.SpatialTypeMultiPolygon),
+							string(documentdb.SpatialTypePoint),
+							string(documentdb.SpatialTypePolygon),
+						}, false),
 					},
 				},
 			},
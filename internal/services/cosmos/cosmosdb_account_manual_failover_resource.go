@@ -0,0 +1,239 @@
+package cosmos
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-10-15/documentdb" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type CosmosDbAccountManualFailoverModel struct {
+	CosmosDbAccountId   string            `tfschema:"cosmosdb_account_id"`
+	TargetWriteLocation string            `tfschema:"target_write_location"`
+	Triggers            map[string]string `tfschema:"triggers"`
+	WriteLocation       string            `tfschema:"write_location"`
+}
+
+type CosmosDbAccountManualFailoverResource struct{}
+
+var _ sdk.Resource = CosmosDbAccountManualFailoverResource{}
+
+func (r CosmosDbAccountManualFailoverResource) ResourceType() string {
+	return "azurerm_cosmosdb_account_manual_failover"
+}
+
+func (r CosmosDbAccountManualFailoverResource) ModelObject() interface{} {
+	return &CosmosDbAccountManualFailoverModel{}
+}
+
+func (r CosmosDbAccountManualFailoverResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.DatabaseAccountManualFailoverID
+}
+
+func (r CosmosDbAccountManualFailoverResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"cosmosdb_account_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.DatabaseAccountID,
+		},
+
+		"target_write_location": commonschema.Location(),
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r CosmosDbAccountManualFailoverResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"write_location": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r CosmosDbAccountManualFailoverResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 3 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model CosmosDbAccountManualFailoverModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Cosmos.DatabaseClient
+
+			accountId, err := parse.DatabaseAccountID(model.CosmosDbAccountId)
+			if err != nil {
+				return err
+			}
+
+			targetLocation := location.Normalize(model.TargetWriteLocation)
+			id := parse.NewDatabaseAccountManualFailoverID(accountId.SubscriptionId, accountId.ResourceGroup, accountId.Name, targetLocation)
+
+			// a manual failover is an action rather than a resource, so there's nothing to check for an existing
+			// resource against - instead the current locations of the account are used to build the new priorities
+			account, err := client.Get(ctx, accountId.ResourceGroup, accountId.Name)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *accountId, err)
+			}
+
+			props := account.DatabaseAccountGetProperties
+			if props == nil || props.Locations == nil {
+				return fmt.Errorf("retrieving %s: `properties.locations` was nil", *accountId)
+			}
+
+			if pointer.From(props.EnableMultipleWriteLocations) {
+				return fmt.Errorf("a manual failover can't be triggered for %s since multiple write locations are enabled", *accountId)
+			}
+
+			locations, err := expandCosmosDbAccountManualFailoverLocations(*props.Locations, targetLocation)
+			if err != nil {
+				return fmt.Errorf("%s: %+v", *accountId, err)
+			}
+
+			if err := resourceCosmosDbAccountFailoverPriorityChange(ctx, client, *accountId, locations); err != nil {
+				return err
+			}
+
+			account, err = client.Get(ctx, accountId.ResourceGroup, accountId.Name)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *accountId, err)
+			}
+
+			writeLocation := ""
+			if props := account.DatabaseAccountGetProperties; props != nil && props.WriteLocations != nil && len(*props.WriteLocations) > 0 {
+				writeLocation = location.Normalize(pointer.From((*props.WriteLocations)[0].LocationName))
+			}
+
+			// the resulting write location isn't exposed by the action itself, so it's recorded at creation time
+			if err := metadata.ResourceData.Set("write_location", writeLocation); err != nil {
+				return fmt.Errorf("setting `write_location`: %+v", err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r CosmosDbAccountManualFailoverResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.DatabaseClient
+
+			id, err := parse.DatabaseAccountManualFailoverID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			accountId := parse.NewDatabaseAccountID(id.SubscriptionId, id.ResourceGroup, id.DatabaseAccountName)
+
+			// the API doesn't expose the details of a failover once it's completed, so the remaining values are retained
+			// from the state - however this is removed from the state once the Database Account has been deleted
+			resp, err := client.Get(ctx, accountId.ResourceGroup, accountId.Name)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", accountId, err)
+			}
+
+			var state CosmosDbAccountManualFailoverModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			state.CosmosDbAccountId = accountId.ID()
+			state.TargetWriteLocation = id.ManualFailoverName
+
+			// when imported the write location at the time of the failover isn't known, so the current one is used
+			if state.WriteLocation == "" {
+				if props := resp.DatabaseAccountGetProperties; props != nil && props.WriteLocations != nil && len(*props.WriteLocations) > 0 {
+					state.WriteLocation = location.Normalize(pointer.From((*props.WriteLocations)[0].LocationName))
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r CosmosDbAccountManualFailoverResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.DatabaseAccountManualFailoverID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// a failover can't be undone, so this only removes the Manual Failover from the state
+			log.Printf("[DEBUG] %s can't be deleted - removing from state", *id)
+			return nil
+		},
+	}
+}
+
+// expandCosmosDbAccountManualFailoverLocations moves the target location to failover priority 0, with the remaining
+// locations retaining their existing order
+func expandCosmosDbAccountManualFailoverLocations(input []documentdb.Location, targetLocation string) ([]documentdb.Location, error) {
+	existing := make([]documentdb.Location, 0)
+	found := false
+	for _, l := range input {
+		if l.LocationName == nil || l.FailoverPriority == nil {
+			continue
+		}
+
+		if location.Normalize(*l.LocationName) == targetLocation {
+			found = true
+			continue
+		}
+
+		existing = append(existing, l)
+	}
+
+	if !found {
+		return nil, fmt.Errorf("the location %q is not configured as a `geo_location`", targetLocation)
+	}
+
+	sort.Slice(existing, func(i, j int) bool {
+		return *existing[i].FailoverPriority < *existing[j].FailoverPriority
+	})
+
+	output := []documentdb.Location{
+		{
+			LocationName:     pointer.To(targetLocation),
+			FailoverPriority: pointer.To(int32(0)),
+		},
+	}
+	for i, l := range existing {
+		output = append(output, documentdb.Location{
+			LocationName:     l.LocationName,
+			FailoverPriority: pointer.To(int32(i + 1)),
+		})
+	}
+
+	return output, nil
+}
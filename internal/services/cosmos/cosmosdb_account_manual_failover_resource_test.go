@@ -0,0 +1,136 @@
+package cosmos_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type CosmosDbAccountManualFailoverResource struct{}
+
+func TestAccCosmosDbAccountManualFailover_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_account_manual_failover", "test")
+	r := CosmosDbAccountManualFailoverResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("write_location").HasValue(data.Locations.Secondary),
+			),
+		},
+		data.ImportStep("triggers"),
+	})
+}
+
+func TestAccCosmosDbAccountManualFailover_triggers(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_account_manual_failover", "test")
+	r := CosmosDbAccountManualFailoverResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.triggers(data, data.Locations.Secondary, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("write_location").HasValue(data.Locations.Secondary),
+			),
+		},
+		{
+			Config: r.triggers(data, data.Locations.Primary, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("write_location").HasValue(data.Locations.Primary),
+			),
+		},
+	})
+}
+
+func (r CosmosDbAccountManualFailoverResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.DatabaseAccountManualFailoverID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Cosmos.DatabaseClient.Get(ctx, id.ResourceGroup, id.DatabaseAccountName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving Database Account %q (Resource Group %q): %+v", id.DatabaseAccountName, id.ResourceGroup, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r CosmosDbAccountManualFailoverResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-cosmos-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_cosmosdb_account" "test" {
+  name                = "acctest-ca-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  offer_type          = "Standard"
+  kind                = "GlobalDocumentDB"
+
+  consistency_policy {
+    consistency_level = "Session"
+  }
+
+  geo_location {
+    location          = azurerm_resource_group.test.location
+    failover_priority = 0
+  }
+
+  geo_location {
+    location          = "%[3]s"
+    failover_priority = 1
+  }
+
+  lifecycle {
+    ignore_changes = [geo_location]
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.Locations.Secondary)
+}
+
+func (r CosmosDbAccountManualFailoverResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_account_manual_failover" "test" {
+  cosmosdb_account_id   = azurerm_cosmosdb_account.test.id
+  target_write_location = "%s"
+}
+`, r.template(data), data.Locations.Secondary)
+}
+
+func (r CosmosDbAccountManualFailoverResource) triggers(data acceptance.TestData, location, trigger string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_account_manual_failover" "test" {
+  cosmosdb_account_id   = azurerm_cosmosdb_account.test.id
+  target_write_location = "%s"
+
+  triggers = {
+    drill = "%s"
+  }
+}
+`, r.template(data), location, trigger)
+}
@@ -7,6 +7,7 @@ import (
 	"log"
 	"net/http"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
@@ -45,23 +46,29 @@ var connStringPropertyMap = map[string]string{
 type databaseAccountCapabilities string
 
 const (
-	databaseAccountCapabilitiesEnableAggregationPipeline      databaseAccountCapabilities = "EnableAggregationPipeline"
-	databaseAccountCapabilitiesEnableCassandra                databaseAccountCapabilities = "EnableCassandra"
-	databaseAccountCapabilitiesEnableGremlin                  databaseAccountCapabilities = "EnableGremlin"
-	databaseAccountCapabilitiesEnableTable                    databaseAccountCapabilities = "EnableTable"
-	databaseAccountCapabilitiesEnableServerless               databaseAccountCapabilities = "EnableServerless"
-	databaseAccountCapabilitiesEnableMongo                    databaseAccountCapabilities = "EnableMongo"
-	databaseAccountCapabilitiesEnableMongo16MBDocumentSupport databaseAccountCapabilities = "EnableMongo16MBDocumentSupport"
-	databaseAccountCapabilitiesMongoDBv34                     databaseAccountCapabilities = "MongoDBv3.4"
-	databaseAccountCapabilitiesMongoEnableDocLevelTTL         databaseAccountCapabilities = "mongoEnableDocLevelTTL"
-	databaseAccountCapabilitiesDisableRateLimitingResponses   databaseAccountCapabilities = "DisableRateLimitingResponses"
-	databaseAccountCapabilitiesAllowSelfServeUpgradeToMongo36 databaseAccountCapabilities = "AllowSelfServeUpgradeToMongo36"
+	databaseAccountCapabilitiesEnableAggregationPipeline         databaseAccountCapabilities = "EnableAggregationPipeline"
+	databaseAccountCapabilitiesEnableCassandra                   databaseAccountCapabilities = "EnableCassandra"
+	databaseAccountCapabilitiesEnableGremlin                     databaseAccountCapabilities = "EnableGremlin"
+	databaseAccountCapabilitiesEnableTable                       databaseAccountCapabilities = "EnableTable"
+	databaseAccountCapabilitiesEnableServerless                  databaseAccountCapabilities = "EnableServerless"
+	databaseAccountCapabilitiesEnableMongo                       databaseAccountCapabilities = "EnableMongo"
+	databaseAccountCapabilitiesEnableMongo16MBDocumentSupport    databaseAccountCapabilities = "EnableMongo16MBDocumentSupport"
+	databaseAccountCapabilitiesEnableMongoRoleBasedAccessControl databaseAccountCapabilities = "EnableMongoRoleBasedAccessControl"
+	databaseAccountCapabilitiesEnableNoSQLVectorSearch           databaseAccountCapabilities = "EnableNoSQLVectorSearch"
+	databaseAccountCapabilitiesEnableNoSQLFullTextSearch         databaseAccountCapabilities = "EnableNoSQLFullTextSearch"
+	databaseAccountCapabilitiesMongoDBv34                        databaseAccountCapabilities = "MongoDBv3.4"
+	databaseAccountCapabilitiesMongoEnableDocLevelTTL            databaseAccountCapabilities = "mongoEnableDocLevelTTL"
+	databaseAccountCapabilitiesDisableRateLimitingResponses      databaseAccountCapabilities = "DisableRateLimitingResponses"
+	databaseAccountCapabilitiesAllowSelfServeUpgradeToMongo36    databaseAccountCapabilities = "AllowSelfServeUpgradeToMongo36"
 )
 
 /*
 	The mapping of capabilities and kinds of cosmosdb account confirmed by service team is as follows:
 
 EnableMongo :                    MongoDB
+EnableMongoRoleBasedAccessControl : MongoDB
+EnableNoSQLVectorSearch :        GlobalDocumentDB
+EnableNoSQLFullTextSearch :      GlobalDocumentDB
 EnableCassandra :                GlobalDocumentDB, Parse
 EnableGremlin :                  GlobalDocumentDB, Parse
 EnableTable :                    GlobalDocumentDB, Parse
@@ -73,17 +80,20 @@ DisableRateLimitingResponses :   GlobalDocumentDB, MongoDB, Parse
 AllowSelfServeUpgradeToMongo36 : GlobalDocumentDB, MongoDB, Parse
 */
 var capabilitiesToKindMap = map[string]interface{}{
-	strings.ToLower(string(databaseAccountCapabilitiesEnableMongo)):                    []string{strings.ToLower(string(documentdb.DatabaseAccountKindMongoDB))},
-	strings.ToLower(string(databaseAccountCapabilitiesEnableMongo16MBDocumentSupport)): []string{strings.ToLower(string(documentdb.DatabaseAccountKindMongoDB))},
-	strings.ToLower(string(databaseAccountCapabilitiesEnableCassandra)):                []string{strings.ToLower(string(documentdb.DatabaseAccountKindGlobalDocumentDB)), strings.ToLower(string(documentdb.DatabaseAccountKindParse))},
-	strings.ToLower(string(databaseAccountCapabilitiesEnableGremlin)):                  []string{strings.ToLower(string(documentdb.DatabaseAccountKindGlobalDocumentDB)), strings.ToLower(string(documentdb.DatabaseAccountKindParse))},
-	strings.ToLower(string(databaseAccountCapabilitiesEnableTable)):                    []string{strings.ToLower(string(documentdb.DatabaseAccountKindGlobalDocumentDB)), strings.ToLower(string(documentdb.DatabaseAccountKindParse))},
-	strings.ToLower(string(databaseAccountCapabilitiesEnableServerless)):               []string{strings.ToLower(string(documentdb.DatabaseAccountKindGlobalDocumentDB)), strings.ToLower(string(documentdb.DatabaseAccountKindMongoDB)), strings.ToLower(string(documentdb.DatabaseAccountKindParse))},
-	strings.ToLower(string(databaseAccountCapabilitiesEnableAggregationPipeline)):      []string{strings.ToLower(string(documentdb.DatabaseAccountKindGlobalDocumentDB)), strings.ToLower(string(documentdb.DatabaseAccountKindMongoDB)), strings.ToLower(string(documentdb.DatabaseAccountKindParse))},
-	strings.ToLower(string(databaseAccountCapabilitiesMongoDBv34)):                     []string{strings.ToLower(string(documentdb.DatabaseAccountKindGlobalDocumentDB)), strings.ToLower(string(documentdb.DatabaseAccountKindMongoDB)), strings.ToLower(string(documentdb.DatabaseAccountKindParse))},
-	strings.ToLower(string(databaseAccountCapabilitiesMongoEnableDocLevelTTL)):         []string{strings.ToLower(string(documentdb.DatabaseAccountKindGlobalDocumentDB)), strings.ToLower(string(documentdb.DatabaseAccountKindMongoDB)), strings.ToLower(string(documentdb.DatabaseAccountKindParse))},
-	strings.ToLower(string(databaseAccountCapabilitiesDisableRateLimitingResponses)):   []string{strings.ToLower(string(documentdb.DatabaseAccountKindGlobalDocumentDB)), strings.ToLower(string(documentdb.DatabaseAccountKindMongoDB)), strings.ToLower(string(documentdb.DatabaseAccountKindParse))},
-	strings.ToLower(string(databaseAccountCapabilitiesAllowSelfServeUpgradeToMongo36)): []string{strings.ToLower(string(documentdb.DatabaseAccountKindGlobalDocumentDB)), strings.ToLower(string(documentdb.DatabaseAccountKindMongoDB)), strings.ToLower(string(documentdb.DatabaseAccountKindParse))},
+	strings.ToLower(string(databaseAccountCapabilitiesEnableMongo)):                       []string{strings.ToLower(string(documentdb.DatabaseAccountKindMongoDB))},
+	strings.ToLower(string(databaseAccountCapabilitiesEnableMongo16MBDocumentSupport)):    []string{strings.ToLower(string(documentdb.DatabaseAccountKindMongoDB))},
+	strings.ToLower(string(databaseAccountCapabilitiesEnableMongoRoleBasedAccessControl)): []string{strings.ToLower(string(documentdb.DatabaseAccountKindMongoDB))},
+	strings.ToLower(string(databaseAccountCapabilitiesEnableNoSQLVectorSearch)):           []string{strings.ToLower(string(documentdb.DatabaseAccountKindGlobalDocumentDB))},
+	strings.ToLower(string(databaseAccountCapabilitiesEnableNoSQLFullTextSearch)):         []string{strings.ToLower(string(documentdb.DatabaseAccountKindGlobalDocumentDB))},
+	strings.ToLower(string(databaseAccountCapabilitiesEnableCassandra)):                   []string{strings.ToLower(string(documentdb.DatabaseAccountKindGlobalDocumentDB)), strings.ToLower(string(documentdb.DatabaseAccountKindParse))},
+	strings.ToLower(string(databaseAccountCapabilitiesEnableGremlin)):                     []string{strings.ToLower(string(documentdb.DatabaseAccountKindGlobalDocumentDB)), strings.ToLower(string(documentdb.DatabaseAccountKindParse))},
+	strings.ToLower(string(databaseAccountCapabilitiesEnableTable)):                       []string{strings.ToLower(string(documentdb.DatabaseAccountKindGlobalDocumentDB)), strings.ToLower(string(documentdb.DatabaseAccountKindParse))},
+	strings.ToLower(string(databaseAccountCapabilitiesEnableServerless)):                  []string{strings.ToLower(string(documentdb.DatabaseAccountKindGlobalDocumentDB)), strings.ToLower(string(documentdb.DatabaseAccountKindMongoDB)), strings.ToLower(string(documentdb.DatabaseAccountKindParse))},
+	strings.ToLower(string(databaseAccountCapabilitiesEnableAggregationPipeline)):         []string{strings.ToLower(string(documentdb.DatabaseAccountKindGlobalDocumentDB)), strings.ToLower(string(documentdb.DatabaseAccountKindMongoDB)), strings.ToLower(string(documentdb.DatabaseAccountKindParse))},
+	strings.ToLower(string(databaseAccountCapabilitiesMongoDBv34)):                        []string{strings.ToLower(string(documentdb.DatabaseAccountKindGlobalDocumentDB)), strings.ToLower(string(documentdb.DatabaseAccountKindMongoDB)), strings.ToLower(string(documentdb.DatabaseAccountKindParse))},
+	strings.ToLower(string(databaseAccountCapabilitiesMongoEnableDocLevelTTL)):            []string{strings.ToLower(string(documentdb.DatabaseAccountKindGlobalDocumentDB)), strings.ToLower(string(documentdb.DatabaseAccountKindMongoDB)), strings.ToLower(string(documentdb.DatabaseAccountKindParse))},
+	strings.ToLower(string(databaseAccountCapabilitiesDisableRateLimitingResponses)):      []string{strings.ToLower(string(documentdb.DatabaseAccountKindGlobalDocumentDB)), strings.ToLower(string(documentdb.DatabaseAccountKindMongoDB)), strings.ToLower(string(documentdb.DatabaseAccountKindParse))},
+	strings.ToLower(string(databaseAccountCapabilitiesAllowSelfServeUpgradeToMongo36)):    []string{strings.ToLower(string(documentdb.DatabaseAccountKindGlobalDocumentDB)), strings.ToLower(string(documentdb.DatabaseAccountKindMongoDB)), strings.ToLower(string(documentdb.DatabaseAccountKindParse))},
 }
 
 // If the consistency policy of the Cosmos DB Database Account is not bounded staleness,
@@ -392,6 +402,9 @@ func resourceCosmosDbAccount() *pluginsdk.Resource {
 								string(databaseAccountCapabilitiesEnableServerless),
 								string(databaseAccountCapabilitiesEnableMongo),
 								string(databaseAccountCapabilitiesEnableMongo16MBDocumentSupport),
+								string(databaseAccountCapabilitiesEnableMongoRoleBasedAccessControl),
+								string(databaseAccountCapabilitiesEnableNoSQLVectorSearch),
+								string(databaseAccountCapabilitiesEnableNoSQLFullTextSearch),
 								string(databaseAccountCapabilitiesMongoDBv34),
 								string(databaseAccountCapabilitiesMongoEnableDocLevelTTL),
 								string(databaseAccountCapabilitiesDisableRateLimitingResponses),
@@ -855,6 +868,20 @@ func resourceCosmosDbAccountUpdate(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("making Read request on %s: %s", id, err)
 	}
 
+	// changing the failover priorities of the existing locations has to be done using the dedicated operation, since the
+	// account can't be updated at the same time - so the existing locations are re-ordered prior to any other changes,
+	// after which any locations are added/removed
+	if existingLocations, changed := cosmosDbAccountExistingLocationsFailoverPriorities(resp.Locations, newLocations); d.HasChange("geo_location") && changed {
+		if err := resourceCosmosDbAccountFailoverPriorityChange(ctx, client, id, existingLocations); err != nil {
+			return err
+		}
+
+		resp, err = client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			return fmt.Errorf("re-retrieving %s after changing the failover priorities: %+v", id, err)
+		}
+	}
+
 	capabilities := make([]documentdb.Capability, 0)
 	for _, v := range *resp.Capabilities {
 		c := documentdb.Capability{
@@ -1358,6 +1385,121 @@ func resourceCosmosDbAccountApiUpsert(client *documentdb.DatabaseAccountsClient,
 	return nil
 }
 
+// cosmosDbAccountExistingLocationsFailoverPriorities returns the existing locations ordered by the failover priorities
+// of the new locations, with any locations which are being removed ordered last - and whether any of the failover
+// priorities of the existing locations have changed
+func cosmosDbAccountExistingLocationsFailoverPriorities(existing *[]documentdb.Location, locations []documentdb.Location) ([]documentdb.Location, bool) {
+	if existing == nil {
+		return nil, false
+	}
+
+	newPriorities := make(map[string]int32)
+	for _, l := range locations {
+		newPriorities[azure.NormalizeLocation(*l.LocationName)] = *l.FailoverPriority
+	}
+
+	retained := make([]documentdb.Location, 0)
+	removed := make([]documentdb.Location, 0)
+	for _, l := range *existing {
+		if l.LocationName == nil || l.FailoverPriority == nil {
+			return nil, false
+		}
+		if _, ok := newPriorities[azure.NormalizeLocation(*l.LocationName)]; ok {
+			retained = append(retained, l)
+		} else {
+			removed = append(removed, l)
+		}
+	}
+
+	sort.SliceStable(retained, func(i, j int) bool {
+		return newPriorities[azure.NormalizeLocation(*retained[i].LocationName)] < newPriorities[azure.NormalizeLocation(*retained[j].LocationName)]
+	})
+	sort.SliceStable(removed, func(i, j int) bool {
+		return *removed[i].FailoverPriority < *removed[j].FailoverPriority
+	})
+
+	changed := false
+	output := make([]documentdb.Location, 0, len(*existing))
+	for i, l := range append(retained, removed...) {
+		if *l.FailoverPriority != int32(i) {
+			changed = true
+		}
+		output = append(output, documentdb.Location{
+			LocationName:     l.LocationName,
+			FailoverPriority: utils.Int32(int32(i)),
+			IsZoneRedundant:  l.IsZoneRedundant,
+		})
+	}
+
+	return output, changed
+}
+
+func resourceCosmosDbAccountFailoverPriorityChange(ctx context.Context, client *documentdb.DatabaseAccountsClient, id parse.DatabaseAccountId, locations []documentdb.Location) error {
+	policies := make([]documentdb.FailoverPolicy, 0)
+	writeLocation := ""
+	for _, l := range locations {
+		policies = append(policies, documentdb.FailoverPolicy{
+			LocationName:     l.LocationName,
+			FailoverPriority: l.FailoverPriority,
+		})
+
+		if *l.FailoverPriority == 0 {
+			writeLocation = azure.NormalizeLocation(*l.LocationName)
+		}
+	}
+
+	future, err := client.FailoverPriorityChange(ctx, id.ResourceGroup, id.Name, documentdb.FailoverPolicies{
+		FailoverPolicies: &policies,
+	})
+	if err != nil {
+		return fmt.Errorf("changing the failover priorities of %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the failover priorities of %s to change: %+v", id, err)
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context had no deadline")
+	}
+
+	// the write location can take some time to be switched over once the operation has completed
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{"Updating"},
+		Target:     []string{"Succeeded"},
+		MinTimeout: 30 * time.Second,
+		Timeout:    time.Until(deadline),
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+			if err != nil {
+				return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			// when multiple write locations are enabled every location is returned as a write location, so only the
+			// location with a failover priority of 0 is compared
+			if props := resp.DatabaseAccountGetProperties; props != nil && props.WriteLocations != nil {
+				for _, l := range *props.WriteLocations {
+					if l.FailoverPriority != nil && *l.FailoverPriority == 0 && (l.LocationName == nil || azure.NormalizeLocation(*l.LocationName) != writeLocation) {
+						return resp, "Updating", nil
+					}
+					if l.ProvisioningState != nil && *l.ProvisioningState != "Succeeded" {
+						return resp, "Updating", nil
+					}
+				}
+			}
+
+			return resp, "Succeeded", nil
+		},
+	}
+
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for the failover priorities of %s to change: %+v", id, err)
+	}
+
+	return nil
+}
+
 func expandAzureRmCosmosDBAccountConsistencyPolicy(d *pluginsdk.ResourceData) *documentdb.ConsistencyPolicy {
 	i := d.Get("consistency_policy").([]interface{})
 	if len(i) == 0 || i[0] == nil {
@@ -1852,14 +1994,18 @@ func flattenCosmosdbAccountDatabasesToRestore(input *[]documentdb.DatabaseRestor
 
 func checkCapabilitiesCanBeUpdated(kind string, oldCapabilities *[]documentdb.Capability, newCapabilities *[]documentdb.Capability) bool {
 	// The feedback from service team : "DisableRateLimitingResponses", "AllowSelfServeUpgradeToMongo36","EnableAggregationPipeline","MongoDBv3.4"
-	// , "mongoEnableDocLevelTTL" and "EnableMongo16MBDocumentSupport" of capabilities can be added to an existing account, others can not.
+	// , "mongoEnableDocLevelTTL", "EnableMongo16MBDocumentSupport", "EnableMongoRoleBasedAccessControl", "EnableNoSQLVectorSearch" and "EnableNoSQLFullTextSearch"
+	// of capabilities can be added to an existing account, others can not.
 	canBeAddedCaps := []string{
 		strings.ToLower(string(databaseAccountCapabilitiesDisableRateLimitingResponses)),
 		strings.ToLower(string(databaseAccountCapabilitiesAllowSelfServeUpgradeToMongo36)),
 		strings.ToLower(string(databaseAccountCapabilitiesEnableAggregationPipeline)),
 		strings.ToLower(string(databaseAccountCapabilitiesMongoDBv34)),
 		strings.ToLower(string(databaseAccountCapabilitiesMongoEnableDocLevelTTL)),
-		strings.ToLower(string(databaseAccountCapabilitiesEnableMongo16MBDocumentSupport))}
+		strings.ToLower(string(databaseAccountCapabilitiesEnableMongo16MBDocumentSupport)),
+		strings.ToLower(string(databaseAccountCapabilitiesEnableMongoRoleBasedAccessControl)),
+		strings.ToLower(string(databaseAccountCapabilitiesEnableNoSQLVectorSearch)),
+		strings.ToLower(string(databaseAccountCapabilitiesEnableNoSQLFullTextSearch))}
 
 	// The feedback from service team : only "DisableRateLimitingResponses" of capabilities can be removed to an existing account.
 	canBeRemovedCaps := []string{
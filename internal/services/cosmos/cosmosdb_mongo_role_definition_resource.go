@@ -0,0 +1,367 @@
+package cosmos
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2022-08-15/mongorbacs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type CosmosDbMongoRoleDefinitionModel struct {
+	CosmosMongoDatabaseId string                                 `tfschema:"cosmos_mongo_database_id"`
+	RoleName              string                                 `tfschema:"role_name"`
+	InheritedRoleNames    []string                               `tfschema:"inherited_role_names"`
+	Privileges            []CosmosDbMongoRoleDefinitionPrivilege `tfschema:"privilege"`
+}
+
+type CosmosDbMongoRoleDefinitionPrivilege struct {
+	Actions  []string                                       `tfschema:"actions"`
+	Resource []CosmosDbMongoRoleDefinitionPrivilegeResource `tfschema:"resource"`
+}
+
+type CosmosDbMongoRoleDefinitionPrivilegeResource struct {
+	CollectionName string `tfschema:"collection_name"`
+	DbName         string `tfschema:"db_name"`
+}
+
+type CosmosDbMongoRoleDefinitionResource struct{}
+
+var _ sdk.ResourceWithUpdate = CosmosDbMongoRoleDefinitionResource{}
+
+func (r CosmosDbMongoRoleDefinitionResource) ResourceType() string {
+	return "azurerm_cosmosdb_mongo_role_definition"
+}
+
+func (r CosmosDbMongoRoleDefinitionResource) ModelObject() interface{} {
+	return &CosmosDbMongoRoleDefinitionModel{}
+}
+
+func (r CosmosDbMongoRoleDefinitionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return mongorbacs.ValidateMongodbRoleDefinitionID
+}
+
+func (r CosmosDbMongoRoleDefinitionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"cosmos_mongo_database_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.MongodbDatabaseID,
+		},
+
+		"role_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"inherited_role_names": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"privilege": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"actions": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						MinItems: 1,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"resource": {
+						Type:     pluginsdk.TypeList,
+						Required: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"collection_name": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"db_name": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r CosmosDbMongoRoleDefinitionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r CosmosDbMongoRoleDefinitionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model CosmosDbMongoRoleDefinitionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Cosmos.MongoRBACClient
+
+			databaseId, err := parse.MongodbDatabaseID(model.CosmosMongoDatabaseId)
+			if err != nil {
+				return err
+			}
+
+			if err := checkCosmosDbMongoRoleBasedAccessControlEnabled(ctx, metadata.Client, databaseId); err != nil {
+				return err
+			}
+
+			// the ID of a Mongo Role Definition is required to be in the format `{databaseName}.{roleName}`
+			id := mongorbacs.NewMongodbRoleDefinitionID(databaseId.SubscriptionId, databaseId.ResourceGroup, databaseId.DatabaseAccountName, fmt.Sprintf("%s.%s", databaseId.Name, model.RoleName))
+			existing, err := client.MongoDBResourcesGetMongoRoleDefinition(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := mongorbacs.MongoRoleDefinitionCreateUpdateParameters{
+				Properties: &mongorbacs.MongoRoleDefinitionResource{
+					DatabaseName: pointer.To(databaseId.Name),
+					Privileges:   expandCosmosDbMongoRoleDefinitionPrivileges(model.Privileges),
+					RoleName:     pointer.To(model.RoleName),
+					Roles:        expandCosmosDbMongoRoles(databaseId.Name, model.InheritedRoleNames),
+					Type:         pointer.To(mongorbacs.MongoRoleDefinitionTypeCustomRole),
+				},
+			}
+
+			if err := client.MongoDBResourcesCreateUpdateMongoRoleDefinitionThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r CosmosDbMongoRoleDefinitionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.MongoRBACClient
+
+			id, err := mongorbacs.ParseMongodbRoleDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.MongoDBResourcesGetMongoRoleDefinition(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := CosmosDbMongoRoleDefinitionModel{}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					databaseName := pointer.From(props.DatabaseName)
+					state.CosmosMongoDatabaseId = parse.NewMongodbDatabaseID(id.SubscriptionId, id.ResourceGroupName, id.AccountName, databaseName).ID()
+					state.RoleName = pointer.From(props.RoleName)
+					state.InheritedRoleNames = flattenCosmosDbMongoRoles(props.Roles)
+					state.Privileges = flattenCosmosDbMongoRoleDefinitionPrivileges(props.Privileges)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r CosmosDbMongoRoleDefinitionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.MongoRBACClient
+
+			id, err := mongorbacs.ParseMongodbRoleDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model CosmosDbMongoRoleDefinitionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			databaseId, err := parse.MongodbDatabaseID(model.CosmosMongoDatabaseId)
+			if err != nil {
+				return err
+			}
+
+			parameters := mongorbacs.MongoRoleDefinitionCreateUpdateParameters{
+				Properties: &mongorbacs.MongoRoleDefinitionResource{
+					DatabaseName: pointer.To(databaseId.Name),
+					Privileges:   expandCosmosDbMongoRoleDefinitionPrivileges(model.Privileges),
+					RoleName:     pointer.To(model.RoleName),
+					Roles:        expandCosmosDbMongoRoles(databaseId.Name, model.InheritedRoleNames),
+					Type:         pointer.To(mongorbacs.MongoRoleDefinitionTypeCustomRole),
+				},
+			}
+
+			if err := client.MongoDBResourcesCreateUpdateMongoRoleDefinitionThenPoll(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r CosmosDbMongoRoleDefinitionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.MongoRBACClient
+
+			id, err := mongorbacs.ParseMongodbRoleDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.MongoDBResourcesDeleteMongoRoleDefinitionThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// checkCosmosDbMongoRoleBasedAccessControlEnabled ensures the `EnableMongoRoleBasedAccessControl` capability is enabled on the
+// Cosmos DB Account, since Mongo Role and User Definitions can't be created otherwise
+func checkCosmosDbMongoRoleBasedAccessControlEnabled(ctx context.Context, client *clients.Client, databaseId *parse.MongodbDatabaseId) error {
+	accountId := parse.NewDatabaseAccountID(databaseId.SubscriptionId, databaseId.ResourceGroup, databaseId.DatabaseAccountName)
+
+	resp, err := client.Cosmos.DatabaseClient.Get(ctx, accountId.ResourceGroup, accountId.Name)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", accountId, err)
+	}
+
+	if props := resp.DatabaseAccountGetProperties; props != nil && props.Capabilities != nil {
+		for _, capability := range *props.Capabilities {
+			if capability.Name != nil && strings.EqualFold(*capability.Name, string(databaseAccountCapabilitiesEnableMongoRoleBasedAccessControl)) {
+				return nil
+			}
+		}
+	}
+
+	return fmt.Errorf("the `%s` capability must be enabled on %s", string(databaseAccountCapabilitiesEnableMongoRoleBasedAccessControl), accountId)
+}
+
+func expandCosmosDbMongoRoles(databaseName string, input []string) *[]mongorbacs.Role {
+	results := make([]mongorbacs.Role, 0)
+
+	for _, role := range input {
+		results = append(results, mongorbacs.Role{
+			Db:   pointer.To(databaseName),
+			Role: pointer.To(role),
+		})
+	}
+
+	return &results
+}
+
+func flattenCosmosDbMongoRoles(input *[]mongorbacs.Role) []string {
+	results := make([]string, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		if item.Role != nil {
+			results = append(results, *item.Role)
+		}
+	}
+
+	return results
+}
+
+func expandCosmosDbMongoRoleDefinitionPrivileges(input []CosmosDbMongoRoleDefinitionPrivilege) *[]mongorbacs.Privilege {
+	results := make([]mongorbacs.Privilege, 0)
+
+	for _, item := range input {
+		privilege := mongorbacs.Privilege{
+			Actions: pointer.To(item.Actions),
+		}
+
+		if len(item.Resource) != 0 {
+			resource := mongorbacs.PrivilegeResource{}
+
+			// an empty value scopes the privilege to all collections and/or databases, so this needs to be sent
+			resource.Collection = pointer.To(item.Resource[0].CollectionName)
+			resource.Db = pointer.To(item.Resource[0].DbName)
+
+			privilege.Resource = &resource
+		}
+
+		results = append(results, privilege)
+	}
+
+	return &results
+}
+
+func flattenCosmosDbMongoRoleDefinitionPrivileges(input *[]mongorbacs.Privilege) []CosmosDbMongoRoleDefinitionPrivilege {
+	results := make([]CosmosDbMongoRoleDefinitionPrivilege, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		privilege := CosmosDbMongoRoleDefinitionPrivilege{
+			Actions: pointer.From(item.Actions),
+		}
+
+		if v := item.Resource; v != nil {
+			privilege.Resource = []CosmosDbMongoRoleDefinitionPrivilegeResource{
+				{
+					CollectionName: pointer.From(v.Collection),
+					DbName:         pointer.From(v.Db),
+				},
+			}
+		}
+
+		results = append(results, privilege)
+	}
+
+	return results
+}
@@ -0,0 +1,698 @@
+package cosmos
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/sdk/2022-11-08/clusters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type CosmosDbPostgreSQLClusterModel struct {
+	Name                             string                                 `tfschema:"name"`
+	ResourceGroupName                string                                 `tfschema:"resource_group_name"`
+	Location                         string                                 `tfschema:"location"`
+	AdministratorLoginPassword       string                                 `tfschema:"administrator_login_password"`
+	CitusVersion                     string                                 `tfschema:"citus_version"`
+	CoordinatorPublicIPAccessEnabled bool                                   `tfschema:"coordinator_public_ip_access_enabled"`
+	CoordinatorServerEdition         string                                 `tfschema:"coordinator_server_edition"`
+	CoordinatorStorageQuotaInMb      int64                                  `tfschema:"coordinator_storage_quota_in_mb"`
+	CoordinatorVCoreCount            int64                                  `tfschema:"coordinator_vcore_count"`
+	HaEnabled                        bool                                   `tfschema:"ha_enabled"`
+	MaintenanceWindow                []CosmosDbPostgreSQLMaintenanceWindow  `tfschema:"maintenance_window"`
+	NodeCount                        int64                                  `tfschema:"node_count"`
+	NodePublicIPAccessEnabled        bool                                   `tfschema:"node_public_ip_access_enabled"`
+	NodeServerEdition                string                                 `tfschema:"node_server_edition"`
+	NodeStorageQuotaInMb             int64                                  `tfschema:"node_storage_quota_in_mb"`
+	NodeVCores                       int64                                  `tfschema:"node_vcores"`
+	PointInTimeInUTC                 string                                 `tfschema:"point_in_time_in_utc"`
+	PreferredPrimaryZone             string                                 `tfschema:"preferred_primary_zone"`
+	ShardsOnCoordinatorEnabled       bool                                   `tfschema:"shards_on_coordinator_enabled"`
+	SourceLocation                   string                                 `tfschema:"source_location"`
+	SourceResourceId                 string                                 `tfschema:"source_resource_id"`
+	SqlVersion                       string                                 `tfschema:"sql_version"`
+	Tags                             map[string]interface{}                 `tfschema:"tags"`
+	EarliestRestoreTime              string                                 `tfschema:"earliest_restore_time"`
+	Servers                          []CosmosDbPostgreSQLClusterServerModel `tfschema:"servers"`
+}
+
+type CosmosDbPostgreSQLMaintenanceWindow struct {
+	DayOfWeek   int64 `tfschema:"day_of_week"`
+	StartHour   int64 `tfschema:"start_hour"`
+	StartMinute int64 `tfschema:"start_minute"`
+}
+
+type CosmosDbPostgreSQLClusterServerModel struct {
+	Name string `tfschema:"name"`
+	Fqdn string `tfschema:"fqdn"`
+}
+
+type CosmosDbPostgreSQLClusterResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate        = CosmosDbPostgreSQLClusterResource{}
+	_ sdk.ResourceWithCustomizeDiff = CosmosDbPostgreSQLClusterResource{}
+)
+
+func (r CosmosDbPostgreSQLClusterResource) ResourceType() string {
+	return "azurerm_cosmosdb_postgresql_cluster"
+}
+
+func (r CosmosDbPostgreSQLClusterResource) ModelObject() interface{} {
+	return &CosmosDbPostgreSQLClusterModel{}
+}
+
+func (r CosmosDbPostgreSQLClusterResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return clusters.ValidateServerGroupsv2ID
+}
+
+func (r CosmosDbPostgreSQLClusterResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.PostgresqlClusterName,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"node_count": {
+			Type:     pluginsdk.TypeInt,
+			Required: true,
+			ValidateFunc: validation.All(
+				validation.IntBetween(0, 20),
+				validation.IntNotInSlice([]int{1}),
+			),
+		},
+
+		"administrator_login_password": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"citus_version": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Computed: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"8.3",
+				"9.0",
+				"9.1",
+				"9.2",
+				"9.3",
+				"9.4",
+				"9.5",
+				"10.0",
+				"10.1",
+				"10.2",
+				"11.0",
+				"11.1",
+				"11.2",
+				"11.3",
+				"12.1",
+			}, false),
+		},
+
+		"coordinator_public_ip_access_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"coordinator_server_edition": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  "GeneralPurpose",
+			ValidateFunc: validation.StringInSlice([]string{
+				"BurstableGeneralPurpose",
+				"BurstableMemoryOptimized",
+				"GeneralPurpose",
+				"MemoryOptimized",
+			}, false),
+		},
+
+		"coordinator_storage_quota_in_mb": {
+			Type:     pluginsdk.TypeInt,
+			Optional: true,
+			Computed: true,
+			ValidateFunc: validation.IntInSlice([]int{
+				32768,
+				65536,
+				131072,
+				262144,
+				524288,
+				1048576,
+				2097152,
+				4194304,
+				8388608,
+				16777216,
+			}),
+		},
+
+		"coordinator_vcore_count": {
+			Type:     pluginsdk.TypeInt,
+			Optional: true,
+			Computed: true,
+			ValidateFunc: validation.IntInSlice([]int{
+				1,
+				2,
+				4,
+				8,
+				16,
+				32,
+				64,
+				96,
+			}),
+		},
+
+		"ha_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"maintenance_window": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"day_of_week": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      0,
+						ValidateFunc: validation.IntBetween(0, 6),
+					},
+
+					"start_hour": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      0,
+						ValidateFunc: validation.IntBetween(0, 23),
+					},
+
+					"start_minute": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Default:      0,
+						ValidateFunc: validation.IntBetween(0, 59),
+					},
+				},
+			},
+		},
+
+		"node_public_ip_access_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
+		"node_server_edition": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  "MemoryOptimized",
+			ValidateFunc: validation.StringInSlice([]string{
+				"GeneralPurpose",
+				"MemoryOptimized",
+			}, false),
+		},
+
+		"node_storage_quota_in_mb": {
+			Type:     pluginsdk.TypeInt,
+			Optional: true,
+			Computed: true,
+			ValidateFunc: validation.IntInSlice([]int{
+				32768,
+				65536,
+				131072,
+				262144,
+				524288,
+				1048576,
+				2097152,
+				4194304,
+				8388608,
+				16777216,
+			}),
+		},
+
+		"node_vcores": {
+			Type:     pluginsdk.TypeInt,
+			Optional: true,
+			Computed: true,
+			ValidateFunc: validation.IntInSlice([]int{
+				1,
+				2,
+				4,
+				8,
+				16,
+				32,
+				64,
+				96,
+				104,
+			}),
+		},
+
+		"point_in_time_in_utc": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+
+		"preferred_primary_zone": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"shards_on_coordinator_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Computed: true,
+		},
+
+		"source_location": commonschema.LocationOptional(),
+
+		"source_resource_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: clusters.ValidateServerGroupsv2ID,
+		},
+
+		"sql_version": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Computed: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"11",
+				"12",
+				"13",
+				"14",
+				"15",
+			}, false),
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r CosmosDbPostgreSQLClusterResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"earliest_restore_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"servers": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"fqdn": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r CosmosDbPostgreSQLClusterResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model CosmosDbPostgreSQLClusterModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// a Cluster is either a new Cluster, a Point in Time Restore of an existing Cluster
+			// (`source_resource_id` and `point_in_time_in_utc`) or a Read Replica of an existing Cluster
+			// (`source_resource_id` and `source_location`), the latter two of which inherit the password - these
+			// values may not be known until apply (e.g. when referencing another resource), so are only checked if known
+			if model.SourceResourceId == "" && metadata.ResourceDiff.NewValueKnown("source_resource_id") {
+				if model.PointInTimeInUTC != "" {
+					return fmt.Errorf("`source_resource_id` must be specified when `point_in_time_in_utc` is specified")
+				}
+				if model.SourceLocation != "" {
+					return fmt.Errorf("`source_resource_id` must be specified when `source_location` is specified")
+				}
+				if metadata.ResourceDiff.Id() == "" && model.AdministratorLoginPassword == "" && metadata.ResourceDiff.NewValueKnown("administrator_login_password") {
+					return fmt.Errorf("`administrator_login_password` must be specified when creating a new Cluster")
+				}
+			}
+
+			if model.PointInTimeInUTC != "" && model.SourceLocation != "" {
+				return fmt.Errorf("only one of `point_in_time_in_utc` and `source_location` can be specified")
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r CosmosDbPostgreSQLClusterResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 3 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model CosmosDbPostgreSQLClusterModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Cosmos.PostgresqlClustersClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			id := clusters.NewServerGroupsv2ID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			properties := clusters.ClusterProperties{
+				CoordinatorEnablePublicIPAccess: pointer.To(model.CoordinatorPublicIPAccessEnabled),
+				CoordinatorServerEdition:        pointer.To(model.CoordinatorServerEdition),
+				EnableHa:                        pointer.To(model.HaEnabled),
+				MaintenanceWindow:               expandCosmosDbPostgreSQLMaintenanceWindow(model.MaintenanceWindow),
+				NodeCount:                       pointer.To(model.NodeCount),
+				NodeEnablePublicIPAccess:        pointer.To(model.NodePublicIPAccessEnabled),
+				NodeServerEdition:               pointer.To(model.NodeServerEdition),
+			}
+
+			if model.AdministratorLoginPassword != "" {
+				properties.AdministratorLoginPassword = pointer.To(model.AdministratorLoginPassword)
+			}
+
+			if model.CitusVersion != "" {
+				properties.CitusVersion = pointer.To(model.CitusVersion)
+			}
+
+			if model.CoordinatorStorageQuotaInMb != 0 {
+				properties.CoordinatorStorageQuotaInMb = pointer.To(model.CoordinatorStorageQuotaInMb)
+			}
+
+			if model.CoordinatorVCoreCount != 0 {
+				properties.CoordinatorVCores = pointer.To(model.CoordinatorVCoreCount)
+			}
+
+			if model.NodeStorageQuotaInMb != 0 {
+				properties.NodeStorageQuotaInMb = pointer.To(model.NodeStorageQuotaInMb)
+			}
+
+			if model.NodeVCores != 0 {
+				properties.NodeVCores = pointer.To(model.NodeVCores)
+			}
+
+			if model.PointInTimeInUTC != "" {
+				v, err := time.Parse(time.RFC3339, model.PointInTimeInUTC)
+				if err != nil {
+					return fmt.Errorf("parsing `point_in_time_in_utc`: %+v", err)
+				}
+				properties.SetPointInTimeUTCAsTime(v)
+			}
+
+			if model.PreferredPrimaryZone != "" {
+				properties.PreferredPrimaryZone = pointer.To(model.PreferredPrimaryZone)
+			}
+
+			if v, ok := metadata.ResourceData.GetOk("shards_on_coordinator_enabled"); ok {
+				properties.EnableShardsOnCoordinator = pointer.To(v.(bool))
+			}
+
+			if model.SourceLocation != "" {
+				properties.SourceLocation = pointer.To(location.Normalize(model.SourceLocation))
+			}
+
+			if model.SourceResourceId != "" {
+				properties.SourceResourceId = pointer.To(model.SourceResourceId)
+			}
+
+			if model.SqlVersion != "" {
+				properties.PostgresqlVersion = pointer.To(model.SqlVersion)
+			}
+
+			parameters := clusters.Cluster{
+				Location:   location.Normalize(model.Location),
+				Properties: &properties,
+				Tags:       tags.Expand(model.Tags),
+			}
+
+			if err := client.CreateThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r CosmosDbPostgreSQLClusterResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.PostgresqlClustersClient
+
+			id, err := clusters.ParseServerGroupsv2ID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			// the password and the source of a Point in Time Restore or Read Replica aren't returned by the API
+			state := CosmosDbPostgreSQLClusterModel{
+				Name:                       id.ServerGroupsv2Name,
+				ResourceGroupName:          id.ResourceGroupName,
+				AdministratorLoginPassword: metadata.ResourceData.Get("administrator_login_password").(string),
+				PointInTimeInUTC:           metadata.ResourceData.Get("point_in_time_in_utc").(string),
+				SourceLocation:             metadata.ResourceData.Get("source_location").(string),
+				SourceResourceId:           metadata.ResourceData.Get("source_resource_id").(string),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = tags.Flatten(model.Tags)
+
+				if props := model.Properties; props != nil {
+					state.CitusVersion = pointer.From(props.CitusVersion)
+					state.CoordinatorPublicIPAccessEnabled = pointer.From(props.CoordinatorEnablePublicIPAccess)
+					state.CoordinatorServerEdition = pointer.From(props.CoordinatorServerEdition)
+					state.CoordinatorStorageQuotaInMb = pointer.From(props.CoordinatorStorageQuotaInMb)
+					state.CoordinatorVCoreCount = pointer.From(props.CoordinatorVCores)
+					state.EarliestRestoreTime = pointer.From(props.EarliestRestoreTime)
+					state.HaEnabled = pointer.From(props.EnableHa)
+					state.MaintenanceWindow = flattenCosmosDbPostgreSQLMaintenanceWindow(props.MaintenanceWindow)
+					state.NodeCount = pointer.From(props.NodeCount)
+					state.NodePublicIPAccessEnabled = pointer.From(props.NodeEnablePublicIPAccess)
+					state.NodeServerEdition = pointer.From(props.NodeServerEdition)
+					state.NodeStorageQuotaInMb = pointer.From(props.NodeStorageQuotaInMb)
+					state.NodeVCores = pointer.From(props.NodeVCores)
+					state.PreferredPrimaryZone = pointer.From(props.PreferredPrimaryZone)
+					state.Servers = flattenCosmosDbPostgreSQLClusterServers(props.ServerNames)
+					state.ShardsOnCoordinatorEnabled = pointer.From(props.EnableShardsOnCoordinator)
+					state.SqlVersion = pointer.From(props.PostgresqlVersion)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r CosmosDbPostgreSQLClusterResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 3 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.PostgresqlClustersClient
+
+			id, err := clusters.ParseServerGroupsv2ID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model CosmosDbPostgreSQLClusterModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			properties := clusters.ClusterPropertiesForUpdate{}
+
+			if metadata.ResourceData.HasChange("administrator_login_password") {
+				properties.AdministratorLoginPassword = pointer.To(model.AdministratorLoginPassword)
+			}
+
+			if metadata.ResourceData.HasChange("citus_version") {
+				properties.CitusVersion = pointer.To(model.CitusVersion)
+			}
+
+			if metadata.ResourceData.HasChange("coordinator_public_ip_access_enabled") {
+				properties.CoordinatorEnablePublicIPAccess = pointer.To(model.CoordinatorPublicIPAccessEnabled)
+			}
+
+			if metadata.ResourceData.HasChange("coordinator_server_edition") {
+				properties.CoordinatorServerEdition = pointer.To(model.CoordinatorServerEdition)
+			}
+
+			if metadata.ResourceData.HasChange("coordinator_storage_quota_in_mb") {
+				properties.CoordinatorStorageQuotaInMb = pointer.To(model.CoordinatorStorageQuotaInMb)
+			}
+
+			if metadata.ResourceData.HasChange("coordinator_vcore_count") {
+				properties.CoordinatorVCores = pointer.To(model.CoordinatorVCoreCount)
+			}
+
+			if metadata.ResourceData.HasChange("ha_enabled") {
+				properties.EnableHa = pointer.To(model.HaEnabled)
+			}
+
+			if metadata.ResourceData.HasChange("maintenance_window") {
+				properties.MaintenanceWindow = expandCosmosDbPostgreSQLMaintenanceWindow(model.MaintenanceWindow)
+			}
+
+			if metadata.ResourceData.HasChange("node_count") {
+				properties.NodeCount = pointer.To(model.NodeCount)
+			}
+
+			if metadata.ResourceData.HasChange("node_public_ip_access_enabled") {
+				properties.NodeEnablePublicIPAccess = pointer.To(model.NodePublicIPAccessEnabled)
+			}
+
+			if metadata.ResourceData.HasChange("node_server_edition") {
+				properties.NodeServerEdition = pointer.To(model.NodeServerEdition)
+			}
+
+			if metadata.ResourceData.HasChange("node_storage_quota_in_mb") {
+				properties.NodeStorageQuotaInMb = pointer.To(model.NodeStorageQuotaInMb)
+			}
+
+			if metadata.ResourceData.HasChange("node_vcores") {
+				properties.NodeVCores = pointer.To(model.NodeVCores)
+			}
+
+			if metadata.ResourceData.HasChange("preferred_primary_zone") {
+				properties.PreferredPrimaryZone = pointer.To(model.PreferredPrimaryZone)
+			}
+
+			if metadata.ResourceData.HasChange("shards_on_coordinator_enabled") {
+				properties.EnableShardsOnCoordinator = pointer.To(model.ShardsOnCoordinatorEnabled)
+			}
+
+			if metadata.ResourceData.HasChange("sql_version") {
+				properties.PostgresqlVersion = pointer.To(model.SqlVersion)
+			}
+
+			parameters := clusters.ClusterForUpdate{
+				Properties: &properties,
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				parameters.Tags = tags.Expand(model.Tags)
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r CosmosDbPostgreSQLClusterResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 3 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.PostgresqlClustersClient
+
+			id, err := clusters.ParseServerGroupsv2ID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandCosmosDbPostgreSQLMaintenanceWindow(input []CosmosDbPostgreSQLMaintenanceWindow) *clusters.MaintenanceWindow {
+	if len(input) == 0 {
+		return &clusters.MaintenanceWindow{
+			CustomWindow: pointer.To("Disabled"),
+		}
+	}
+
+	v := input[0]
+
+	return &clusters.MaintenanceWindow{
+		CustomWindow: pointer.To("Enabled"),
+		DayOfWeek:    pointer.To(v.DayOfWeek),
+		StartHour:    pointer.To(v.StartHour),
+		StartMinute:  pointer.To(v.StartMinute),
+	}
+}
+
+func flattenCosmosDbPostgreSQLMaintenanceWindow(input *clusters.MaintenanceWindow) []CosmosDbPostgreSQLMaintenanceWindow {
+	if input == nil || pointer.From(input.CustomWindow) != "Enabled" {
+		return []CosmosDbPostgreSQLMaintenanceWindow{}
+	}
+
+	return []CosmosDbPostgreSQLMaintenanceWindow{
+		{
+			DayOfWeek:   pointer.From(input.DayOfWeek),
+			StartHour:   pointer.From(input.StartHour),
+			StartMinute: pointer.From(input.StartMinute),
+		},
+	}
+}
+
+func flattenCosmosDbPostgreSQLClusterServers(input *[]clusters.ServerNameItem) []CosmosDbPostgreSQLClusterServerModel {
+	results := make([]CosmosDbPostgreSQLClusterServerModel, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		results = append(results, CosmosDbPostgreSQLClusterServerModel{
+			Name: pointer.From(item.Name),
+			Fqdn: pointer.From(item.FullyQualifiedDomainName),
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,204 @@
+package cosmos
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/sdk/2022-11-08/clusters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/sdk/2022-11-08/firewallrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type CosmosDbPostgreSQLFirewallRuleModel struct {
+	Name           string `tfschema:"name"`
+	ClusterId      string `tfschema:"cluster_id"`
+	StartIPAddress string `tfschema:"start_ip_address"`
+	EndIPAddress   string `tfschema:"end_ip_address"`
+}
+
+type CosmosDbPostgreSQLFirewallRuleResource struct{}
+
+var _ sdk.ResourceWithUpdate = CosmosDbPostgreSQLFirewallRuleResource{}
+
+func (r CosmosDbPostgreSQLFirewallRuleResource) ResourceType() string {
+	return "azurerm_cosmosdb_postgresql_firewall_rule"
+}
+
+func (r CosmosDbPostgreSQLFirewallRuleResource) ModelObject() interface{} {
+	return &CosmosDbPostgreSQLFirewallRuleModel{}
+}
+
+func (r CosmosDbPostgreSQLFirewallRuleResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return firewallrules.ValidateFirewallRuleID
+}
+
+func (r CosmosDbPostgreSQLFirewallRuleResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[-\w.]{1,128}$`),
+				"`name` must be between 1 and 128 characters long and contain only letters, numbers, hyphens, underscores and periods",
+			),
+		},
+
+		"cluster_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: clusters.ValidateServerGroupsv2ID,
+		},
+
+		"start_ip_address": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsIPv4Address,
+		},
+
+		"end_ip_address": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.IsIPv4Address,
+		},
+	}
+}
+
+func (r CosmosDbPostgreSQLFirewallRuleResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r CosmosDbPostgreSQLFirewallRuleResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model CosmosDbPostgreSQLFirewallRuleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Cosmos.PostgresqlFirewallRulesClient
+
+			clusterId, err := clusters.ParseServerGroupsv2ID(model.ClusterId)
+			if err != nil {
+				return err
+			}
+
+			id := firewallrules.NewFirewallRuleID(clusterId.SubscriptionId, clusterId.ResourceGroupName, clusterId.ServerGroupsv2Name, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := firewallrules.FirewallRule{
+				Properties: firewallrules.FirewallRuleProperties{
+					StartIPAddress: model.StartIPAddress,
+					EndIPAddress:   model.EndIPAddress,
+				},
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r CosmosDbPostgreSQLFirewallRuleResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.PostgresqlFirewallRulesClient
+
+			id, err := firewallrules.ParseFirewallRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := CosmosDbPostgreSQLFirewallRuleModel{
+				Name:      id.FirewallRuleName,
+				ClusterId: clusters.NewServerGroupsv2ID(id.SubscriptionId, id.ResourceGroupName, id.ServerGroupsv2Name).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.StartIPAddress = model.Properties.StartIPAddress
+				state.EndIPAddress = model.Properties.EndIPAddress
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r CosmosDbPostgreSQLFirewallRuleResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.PostgresqlFirewallRulesClient
+
+			id, err := firewallrules.ParseFirewallRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model CosmosDbPostgreSQLFirewallRuleModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			parameters := firewallrules.FirewallRule{
+				Properties: firewallrules.FirewallRuleProperties{
+					StartIPAddress: model.StartIPAddress,
+					EndIPAddress:   model.EndIPAddress,
+				},
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r CosmosDbPostgreSQLFirewallRuleResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.PostgresqlFirewallRulesClient
+
+			id, err := firewallrules.ParseFirewallRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,126 @@
+package cosmos_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/sdk/2022-11-08/firewallrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type CosmosDbPostgreSQLFirewallRuleResource struct{}
+
+func TestAccCosmosDbPostgreSQLFirewallRule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_postgresql_firewall_rule", "test")
+	r := CosmosDbPostgreSQLFirewallRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccCosmosDbPostgreSQLFirewallRule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_postgresql_firewall_rule", "test")
+	r := CosmosDbPostgreSQLFirewallRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccCosmosDbPostgreSQLFirewallRule_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_postgresql_firewall_rule", "test")
+	r := CosmosDbPostgreSQLFirewallRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r CosmosDbPostgreSQLFirewallRuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := firewallrules.ParseFirewallRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	client := clients.Cosmos.PostgresqlFirewallRulesClient
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r CosmosDbPostgreSQLFirewallRuleResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_postgresql_firewall_rule" "test" {
+  name             = "acctest-fwr-%d"
+  cluster_id       = azurerm_cosmosdb_postgresql_cluster.test.id
+  start_ip_address = "10.0.17.62"
+  end_ip_address   = "10.0.17.64"
+}
+`, CosmosDbPostgreSQLClusterResource{}.basic(data), data.RandomInteger)
+}
+
+func (r CosmosDbPostgreSQLFirewallRuleResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_postgresql_firewall_rule" "import" {
+  name             = azurerm_cosmosdb_postgresql_firewall_rule.test.name
+  cluster_id       = azurerm_cosmosdb_postgresql_firewall_rule.test.cluster_id
+  start_ip_address = azurerm_cosmosdb_postgresql_firewall_rule.test.start_ip_address
+  end_ip_address   = azurerm_cosmosdb_postgresql_firewall_rule.test.end_ip_address
+}
+`, r.basic(data))
+}
+
+func (r CosmosDbPostgreSQLFirewallRuleResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_postgresql_firewall_rule" "test" {
+  name             = "acctest-fwr-%d"
+  cluster_id       = azurerm_cosmosdb_postgresql_cluster.test.id
+  start_ip_address = "10.0.17.60"
+  end_ip_address   = "10.0.17.70"
+}
+`, CosmosDbPostgreSQLClusterResource{}.basic(data), data.RandomInteger)
+}
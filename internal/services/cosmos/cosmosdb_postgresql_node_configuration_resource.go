@@ -0,0 +1,205 @@
+package cosmos
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/sdk/2022-11-08/clusters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/sdk/2022-11-08/configurations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type CosmosDbPostgreSQLNodeConfigurationModel struct {
+	Name      string `tfschema:"name"`
+	ClusterId string `tfschema:"cluster_id"`
+	Value     string `tfschema:"value"`
+}
+
+type CosmosDbPostgreSQLNodeConfigurationResource struct{}
+
+var _ sdk.ResourceWithUpdate = CosmosDbPostgreSQLNodeConfigurationResource{}
+
+func (r CosmosDbPostgreSQLNodeConfigurationResource) ResourceType() string {
+	return "azurerm_cosmosdb_postgresql_node_configuration"
+}
+
+func (r CosmosDbPostgreSQLNodeConfigurationResource) ModelObject() interface{} {
+	return &CosmosDbPostgreSQLNodeConfigurationModel{}
+}
+
+func (r CosmosDbPostgreSQLNodeConfigurationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return configurations.ValidateNodeConfigurationID
+}
+
+func (r CosmosDbPostgreSQLNodeConfigurationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"cluster_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: clusters.ValidateServerGroupsv2ID,
+		},
+
+		"value": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r CosmosDbPostgreSQLNodeConfigurationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r CosmosDbPostgreSQLNodeConfigurationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model CosmosDbPostgreSQLNodeConfigurationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Cosmos.PostgresqlConfigurationsClient
+
+			clusterId, err := clusters.ParseServerGroupsv2ID(model.ClusterId)
+			if err != nil {
+				return err
+			}
+
+			// Configurations always exist on a Cluster, so there's no requires import check - instead this ensures
+			// that the Configuration is one which is supported on the Nodes
+			id := configurations.NewNodeConfigurationID(clusterId.SubscriptionId, clusterId.ResourceGroupName, clusterId.ServerGroupsv2Name, model.Name)
+			if _, err := client.GetNode(ctx, id); err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			parameters := configurations.ServerConfiguration{
+				Properties: &configurations.ServerConfigurationProperties{
+					Value: model.Value,
+				},
+			}
+
+			if err := client.UpdateOnNodeThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r CosmosDbPostgreSQLNodeConfigurationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.PostgresqlConfigurationsClient
+
+			id, err := configurations.ParseNodeConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.GetNode(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := CosmosDbPostgreSQLNodeConfigurationModel{
+				Name:      id.NodeConfigurationName,
+				ClusterId: clusters.NewServerGroupsv2ID(id.SubscriptionId, id.ResourceGroupName, id.ServerGroupsv2Name).ID(),
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				state.Value = model.Properties.Value
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r CosmosDbPostgreSQLNodeConfigurationResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.PostgresqlConfigurationsClient
+
+			id, err := configurations.ParseNodeConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model CosmosDbPostgreSQLNodeConfigurationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			parameters := configurations.ServerConfiguration{
+				Properties: &configurations.ServerConfigurationProperties{
+					Value: model.Value,
+				},
+			}
+
+			if err := client.UpdateOnNodeThenPoll(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r CosmosDbPostgreSQLNodeConfigurationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cosmos.PostgresqlConfigurationsClient
+
+			id, err := configurations.ParseNodeConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// Configurations can't be deleted, so this resets the Configuration to its default value
+			resp, err := client.GetNode(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if resp.Model == nil || resp.Model.Properties == nil || resp.Model.Properties.DefaultValue == nil {
+				return fmt.Errorf("retrieving %s: `defaultValue` was nil", *id)
+			}
+
+			parameters := configurations.ServerConfiguration{
+				Properties: &configurations.ServerConfigurationProperties{
+					Value: pointer.From(resp.Model.Properties.DefaultValue),
+				},
+			}
+
+			if err := client.UpdateOnNodeThenPoll(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("resetting %s to its default value: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -6,7 +6,7 @@ import (
 	"log"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-10-15/documentdb" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/common"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/sdk/2025-04-15/cosmosdb"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/cosmos/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -126,6 +127,110 @@ func resourceCosmosDbSQLContainer() *pluginsdk.Resource {
 				},
 			},
 			"indexing_policy": common.CosmosDbIndexingPolicySchema(),
+
+			"computed_property": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"query": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"full_text_policy": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"default_language": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"full_text_path": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"path": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"language": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			// the Vector Embedding Policy can only be set when the Container is created
+			"vector_embedding_policy": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"vector_embedding": {
+							Type:     pluginsdk.TypeList,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"path": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"data_type": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(cosmosdb.PossibleValuesForVectorDataType(), false),
+									},
+
+									"dimensions": {
+										Type:         pluginsdk.TypeInt,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.IntBetween(1, 4096),
+									},
+
+									"distance_function": {
+										Type:         pluginsdk.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(cosmosdb.PossibleValuesForDistanceFunction(), false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 
 		CustomizeDiff: pluginsdk.CustomDiffWithAll(
@@ -138,86 +243,53 @@ func resourceCosmosDbSQLContainer() *pluginsdk.Resource {
 }
 
 func resourceCosmosDbSQLContainerCreate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cosmos.SqlClient
+	client := meta.(*clients.Client).Cosmos.SqlContainerClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
 	id := parse.NewSqlContainerID(subscriptionId, d.Get("resource_group_name").(string), d.Get("account_name").(string), d.Get("database_name").(string), d.Get("name").(string))
-	partitionkeypaths := d.Get("partition_key_path").(string)
+	containerId := cosmosdb.NewSqlContainerID(id.SubscriptionId, id.ResourceGroup, id.DatabaseAccountName, id.SqlDatabaseName, id.ContainerName)
 
-	existing, err := client.GetSQLContainer(ctx, id.ResourceGroup, id.DatabaseAccountName, id.SqlDatabaseName, id.ContainerName)
+	existing, err := client.SqlResourcesGetSqlContainer(ctx, containerId)
 	if err != nil {
-		if !utils.ResponseWasNotFound(existing.Response) {
+		if !response.WasNotFound(existing.HttpResponse) {
 			return fmt.Errorf("checking for presence of %s: %+v", id, err)
 		}
-	} else {
-		if existing.ID == nil && *existing.ID == "" {
-			return fmt.Errorf("generating import ID for %s", id)
-		}
-
-		return tf.ImportAsExistsError("azurerm_cosmosdb_sql_container", *existing.ID)
+	}
+	if !response.WasNotFound(existing.HttpResponse) {
+		return tf.ImportAsExistsError("azurerm_cosmosdb_sql_container", id.ID())
 	}
 
-	indexingPolicy := common.ExpandAzureRmCosmosDbIndexingPolicy(d)
-	err = common.ValidateAzureRmCosmosDbIndexingPolicy(indexingPolicy)
+	resource, err := expandCosmosSQLContainerResource(d, id.ContainerName)
 	if err != nil {
-		return fmt.Errorf("generating indexing policy for %s", id)
+		return fmt.Errorf("expanding %s: %+v", id, err)
 	}
 
-	db := documentdb.SQLContainerCreateUpdateParameters{
-		SQLContainerCreateUpdateProperties: &documentdb.SQLContainerCreateUpdateProperties{
-			Resource: &documentdb.SQLContainerResource{
-				ID:                       &id.ContainerName,
-				IndexingPolicy:           indexingPolicy,
-				ConflictResolutionPolicy: common.ExpandCosmosDbConflicResolutionPolicy(d.Get("conflict_resolution_policy").([]interface{})),
-			},
-			Options: &documentdb.CreateUpdateOptions{},
+	db := cosmosdb.SqlContainerCreateUpdateParameters{
+		Properties: cosmosdb.SqlContainerCreateUpdateProperties{
+			Resource: *resource,
+			Options:  &cosmosdb.CreateUpdateOptions{},
 		},
 	}
 
-	if partitionkeypaths != "" {
-		db.SQLContainerCreateUpdateProperties.Resource.PartitionKey = &documentdb.ContainerPartitionKey{
-			Paths: &[]string{partitionkeypaths},
-			Kind:  documentdb.PartitionKindHash,
-		}
-
-		if partitionKeyVersion, ok := d.GetOk("partition_key_version"); ok {
-			db.SQLContainerCreateUpdateProperties.Resource.PartitionKey.Version = utils.Int32(int32(partitionKeyVersion.(int)))
-		}
-	}
-
-	if keys := expandCosmosSQLContainerUniqueKeys(d.Get("unique_key").(*pluginsdk.Set)); keys != nil {
-		db.SQLContainerCreateUpdateProperties.Resource.UniqueKeyPolicy = &documentdb.UniqueKeyPolicy{
-			UniqueKeys: keys,
-		}
-	}
-
-	if analyticalStorageTTL, ok := d.GetOk("analytical_storage_ttl"); ok {
-		db.SQLContainerCreateUpdateProperties.Resource.AnalyticalStorageTTL = utils.Int64(int64(analyticalStorageTTL.(int)))
-	}
-
-	if defaultTTL, hasTTL := d.GetOk("default_ttl"); hasTTL {
-		db.SQLContainerCreateUpdateProperties.Resource.DefaultTTL = utils.Int32(int32(defaultTTL.(int)))
-	}
-
 	if throughput, hasThroughput := d.GetOk("throughput"); hasThroughput {
 		if throughput != 0 {
-			db.SQLContainerCreateUpdateProperties.Options.Throughput = common.ConvertThroughputFromResourceData(throughput)
+			db.Properties.Options.Throughput = pointer.To(int64(throughput.(int)))
 		}
 	}
 
 	if _, hasAutoscaleSettings := d.GetOk("autoscale_settings"); hasAutoscaleSettings {
-		db.SQLContainerCreateUpdateProperties.Options.AutoscaleSettings = common.ExpandCosmosDbAutoscaleSettings(d)
-	}
-
-	future, err := client.CreateUpdateSQLContainer(ctx, id.ResourceGroup, id.DatabaseAccountName, id.SqlDatabaseName, id.ContainerName, db)
-	if err != nil {
-		return fmt.Errorf("issuing create/update request for %s: %+v", id, err)
+		if autoscaleSettings := common.ExpandCosmosDbAutoscaleSettings(d); autoscaleSettings != nil {
+			db.Properties.Options.AutoscaleSettings = &cosmosdb.AutoscaleSettings{}
+			if autoscaleSettings.MaxThroughput != nil {
+				db.Properties.Options.AutoscaleSettings.MaxThroughput = pointer.To(int64(*autoscaleSettings.MaxThroughput))
+			}
+		}
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting on create/update future for %s: %+v", id, err)
+	if err := client.SqlResourcesCreateUpdateSqlContainerThenPoll(ctx, containerId, db); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
 	d.SetId(id.ID())
@@ -226,7 +298,8 @@ func resourceCosmosDbSQLContainerCreate(d *pluginsdk.ResourceData, meta interfac
 }
 
 func resourceCosmosDbSQLContainerUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cosmos.SqlClient
+	client := meta.(*clients.Client).Cosmos.SqlContainerClient
+	throughputClient := meta.(*clients.Client).Cosmos.SqlClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -240,61 +313,26 @@ func resourceCosmosDbSQLContainerUpdate(d *pluginsdk.ResourceData, meta interfac
 		return fmt.Errorf("updating Cosmos SQL Container %q (Account: %q, Database: %q): %+v", id.ContainerName, id.DatabaseAccountName, id.SqlDatabaseName, err)
 	}
 
-	partitionkeypaths := d.Get("partition_key_path").(string)
-
-	indexingPolicy := common.ExpandAzureRmCosmosDbIndexingPolicy(d)
-	err = common.ValidateAzureRmCosmosDbIndexingPolicy(indexingPolicy)
+	resource, err := expandCosmosSQLContainerResource(d, id.ContainerName)
 	if err != nil {
 		return fmt.Errorf("updating Cosmos SQL Container %q (Account: %q, Database: %q): %+v", id.ContainerName, id.DatabaseAccountName, id.SqlDatabaseName, err)
 	}
 
-	db := documentdb.SQLContainerCreateUpdateParameters{
-		SQLContainerCreateUpdateProperties: &documentdb.SQLContainerCreateUpdateProperties{
-			Resource: &documentdb.SQLContainerResource{
-				ID:             &id.ContainerName,
-				IndexingPolicy: indexingPolicy,
-			},
-			Options: &documentdb.CreateUpdateOptions{},
+	db := cosmosdb.SqlContainerCreateUpdateParameters{
+		Properties: cosmosdb.SqlContainerCreateUpdateProperties{
+			Resource: *resource,
+			Options:  &cosmosdb.CreateUpdateOptions{},
 		},
 	}
 
-	if partitionkeypaths != "" {
-		db.SQLContainerCreateUpdateProperties.Resource.PartitionKey = &documentdb.ContainerPartitionKey{
-			Paths: &[]string{partitionkeypaths},
-			Kind:  documentdb.PartitionKindHash,
-		}
-
-		if partitionKeyVersion, ok := d.GetOk("partition_key_version"); ok {
-			db.SQLContainerCreateUpdateProperties.Resource.PartitionKey.Version = utils.Int32(int32(partitionKeyVersion.(int)))
-		}
-	}
-
-	if keys := expandCosmosSQLContainerUniqueKeys(d.Get("unique_key").(*pluginsdk.Set)); keys != nil {
-		db.SQLContainerCreateUpdateProperties.Resource.UniqueKeyPolicy = &documentdb.UniqueKeyPolicy{
-			UniqueKeys: keys,
-		}
-	}
-
-	if analyticalStorageTTL, ok := d.GetOk("analytical_storage_ttl"); ok {
-		db.SQLContainerCreateUpdateProperties.Resource.AnalyticalStorageTTL = utils.Int64(int64(analyticalStorageTTL.(int)))
-	}
-
-	if defaultTTL, hasTTL := d.GetOk("default_ttl"); hasTTL {
-		db.SQLContainerCreateUpdateProperties.Resource.DefaultTTL = utils.Int32(int32(defaultTTL.(int)))
-	}
-
-	future, err := client.CreateUpdateSQLContainer(ctx, id.ResourceGroup, id.DatabaseAccountName, id.SqlDatabaseName, id.ContainerName, db)
-	if err != nil {
-		return fmt.Errorf("issuing create/update request for Cosmos SQL Container %q (Account: %q, Database: %q): %+v", id.ContainerName, id.DatabaseAccountName, id.SqlDatabaseName, err)
-	}
-
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("waiting on create/update future for Cosmos SQL Container %q (Account: %q, Database: %q): %+v", id.ContainerName, id.DatabaseAccountName, id.SqlDatabaseName, err)
+	containerId := cosmosdb.NewSqlContainerID(id.SubscriptionId, id.ResourceGroup, id.DatabaseAccountName, id.SqlDatabaseName, id.ContainerName)
+	if err := client.SqlResourcesCreateUpdateSqlContainerThenPoll(ctx, containerId, db); err != nil {
+		return fmt.Errorf("updating Cosmos SQL Container %q (Account: %q, Database: %q): %+v", id.ContainerName, id.DatabaseAccountName, id.SqlDatabaseName, err)
 	}
 
 	if common.HasThroughputChange(d) {
 		throughputParameters := common.ExpandCosmosDBThroughputSettingsUpdateParameters(d)
-		throughputFuture, err := client.UpdateSQLContainerThroughput(ctx, id.ResourceGroup, id.DatabaseAccountName, id.SqlDatabaseName, id.ContainerName, *throughputParameters)
+		throughputFuture, err := throughputClient.UpdateSQLContainerThroughput(ctx, id.ResourceGroup, id.DatabaseAccountName, id.SqlDatabaseName, id.ContainerName, *throughputParameters)
 		if err != nil {
 			if response.WasNotFound(throughputFuture.Response()) {
 				return fmt.Errorf("setting Throughput for Cosmos SQL Container %q (Account: %q, Database: %q): %+v - "+
@@ -302,7 +340,7 @@ func resourceCosmosDbSQLContainerUpdate(d *pluginsdk.ResourceData, meta interfac
 			}
 		}
 
-		if err = throughputFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+		if err = throughputFuture.WaitForCompletionRef(ctx, throughputClient.Client); err != nil {
 			return fmt.Errorf("waiting on ThroughputUpdate future for Cosmos Container %q (Account: %q, Database: %q): %+v", id.ContainerName, id.DatabaseAccountName, id.SqlDatabaseName, err)
 		}
 	}
@@ -311,7 +349,8 @@ func resourceCosmosDbSQLContainerUpdate(d *pluginsdk.ResourceData, meta interfac
 }
 
 func resourceCosmosDbSQLContainerRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cosmos.SqlClient
+	client := meta.(*clients.Client).Cosmos.SqlContainerClient
+	throughputClient := meta.(*clients.Client).Cosmos.SqlClient
 	accountClient := meta.(*clients.Client).Cosmos.DatabaseClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -321,9 +360,9 @@ func resourceCosmosDbSQLContainerRead(d *pluginsdk.ResourceData, meta interface{
 		return err
 	}
 
-	resp, err := client.GetSQLContainer(ctx, id.ResourceGroup, id.DatabaseAccountName, id.SqlDatabaseName, id.ContainerName)
+	resp, err := client.SqlResourcesGetSqlContainer(ctx, cosmosdb.NewSqlContainerID(id.SubscriptionId, id.ResourceGroup, id.DatabaseAccountName, id.SqlDatabaseName, id.ContainerName))
 	if err != nil {
-		if utils.ResponseWasNotFound(resp.Response) {
+		if response.WasNotFound(resp.HttpResponse) {
 			log.Printf("[INFO] Error reading Cosmos SQL Container %q (Account: %q) - removing from state", id.SqlDatabaseName, id.ContainerName)
 			d.SetId("")
 			return nil
@@ -337,8 +376,8 @@ func resourceCosmosDbSQLContainerRead(d *pluginsdk.ResourceData, meta interface{
 	d.Set("account_name", id.DatabaseAccountName)
 	d.Set("database_name", id.SqlDatabaseName)
 
-	if props := resp.SQLContainerGetProperties; props != nil {
-		if res := props.Resource; res != nil {
+	if model := resp.Model; model != nil && model.Properties != nil {
+		if res := model.Properties.Resource; res != nil {
 			if pk := res.PartitionKey; pk != nil {
 				if paths := pk.Paths; paths != nil {
 					if len(*paths) > 1 {
@@ -358,11 +397,11 @@ func resourceCosmosDbSQLContainerRead(d *pluginsdk.ResourceData, meta interface{
 				}
 			}
 
-			if analyticalStorageTTL := res.AnalyticalStorageTTL; analyticalStorageTTL != nil {
+			if analyticalStorageTTL := res.AnalyticalStorageTtl; analyticalStorageTTL != nil {
 				d.Set("analytical_storage_ttl", analyticalStorageTTL)
 			}
 
-			if defaultTTL := res.DefaultTTL; defaultTTL != nil {
+			if defaultTTL := res.DefaultTtl; defaultTTL != nil {
 				d.Set("default_ttl", defaultTTL)
 			}
 
@@ -370,9 +409,21 @@ func resourceCosmosDbSQLContainerRead(d *pluginsdk.ResourceData, meta interface{
 				d.Set("indexing_policy", common.FlattenAzureRmCosmosDbIndexingPolicy(indexingPolicy))
 			}
 
-			if err := d.Set("conflict_resolution_policy", common.FlattenCosmosDbConflictResolutionPolicy(res.ConflictResolutionPolicy)); err != nil {
+			if err := d.Set("conflict_resolution_policy", flattenCosmosSQLContainerConflictResolutionPolicy(res.ConflictResolutionPolicy)); err != nil {
 				return fmt.Errorf("setting `conflict_resolution_policy`: %+v", err)
 			}
+
+			if err := d.Set("computed_property", flattenCosmosSQLContainerComputedProperties(res.ComputedProperties)); err != nil {
+				return fmt.Errorf("setting `computed_property`: %+v", err)
+			}
+
+			if err := d.Set("full_text_policy", flattenCosmosSQLContainerFullTextPolicy(res.FullTextPolicy)); err != nil {
+				return fmt.Errorf("setting `full_text_policy`: %+v", err)
+			}
+
+			if err := d.Set("vector_embedding_policy", flattenCosmosSQLContainerVectorEmbeddingPolicy(res.VectorEmbeddingPolicy)); err != nil {
+				return fmt.Errorf("setting `vector_embedding_policy`: %+v", err)
+			}
 		}
 	}
 
@@ -387,7 +438,7 @@ func resourceCosmosDbSQLContainerRead(d *pluginsdk.ResourceData, meta interface{
 
 	// if the cosmos account is serverless calling the get throughput api would yield an error
 	if !isServerlessCapacityMode(accResp) {
-		throughputResp, err := client.GetSQLContainerThroughput(ctx, id.ResourceGroup, id.DatabaseAccountName, id.SqlDatabaseName, id.ContainerName)
+		throughputResp, err := throughputClient.GetSQLContainerThroughput(ctx, id.ResourceGroup, id.DatabaseAccountName, id.SqlDatabaseName, id.ContainerName)
 		if err != nil {
 			if !utils.ResponseWasNotFound(throughputResp.Response) {
 				return fmt.Errorf("reading Throughput on Cosmos SQL Container %s (Account: %q, Database: %q) ID: %v", id.ContainerName, id.DatabaseAccountName, id.SqlDatabaseName, err)
@@ -404,7 +455,7 @@ func resourceCosmosDbSQLContainerRead(d *pluginsdk.ResourceData, meta interface{
 }
 
 func resourceCosmosDbSQLContainerDelete(d *pluginsdk.ResourceData, meta interface{}) error {
-	client := meta.(*clients.Client).Cosmos.SqlClient
+	client := meta.(*clients.Client).Cosmos.SqlContainerClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -413,28 +464,63 @@ func resourceCosmosDbSQLContainerDelete(d *pluginsdk.ResourceData, meta interfac
 		return err
 	}
 
-	future, err := client.DeleteSQLContainer(ctx, id.ResourceGroup, id.DatabaseAccountName, id.SqlDatabaseName, id.ContainerName)
-	if err != nil {
+	if err := client.SqlResourcesDeleteSqlContainerThenPoll(ctx, cosmosdb.NewSqlContainerID(id.SubscriptionId, id.ResourceGroup, id.DatabaseAccountName, id.SqlDatabaseName, id.ContainerName)); err != nil {
 		return fmt.Errorf("deleting Cosmos SQL Container %q (Account: %q): %+v", id.SqlDatabaseName, id.ContainerName, err)
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
-	if err != nil {
-		if !response.WasNotFound(future.Response()) {
-			return fmt.Errorf("deleting Cosmos SQL Container %q (Account: %q): %+v", id.SqlDatabaseName, id.ContainerName, err)
+	return nil
+}
+
+func expandCosmosSQLContainerResource(d *pluginsdk.ResourceData, name string) (*cosmosdb.SqlContainerResource, error) {
+	indexingPolicy := common.ExpandAzureRmCosmosDbIndexingPolicy(d)
+	if err := common.ValidateAzureRmCosmosDbIndexingPolicy(indexingPolicy); err != nil {
+		return nil, fmt.Errorf("generating indexing policy: %+v", err)
+	}
+
+	resource := cosmosdb.SqlContainerResource{
+		Id:                       name,
+		IndexingPolicy:           indexingPolicy,
+		ConflictResolutionPolicy: expandCosmosSQLContainerConflictResolutionPolicy(d.Get("conflict_resolution_policy").([]interface{})),
+		ComputedProperties:       expandCosmosSQLContainerComputedProperties(d.Get("computed_property").([]interface{})),
+		FullTextPolicy:           expandCosmosSQLContainerFullTextPolicy(d.Get("full_text_policy").([]interface{})),
+		VectorEmbeddingPolicy:    expandCosmosSQLContainerVectorEmbeddingPolicy(d.Get("vector_embedding_policy").([]interface{})),
+	}
+
+	if partitionkeypaths := d.Get("partition_key_path").(string); partitionkeypaths != "" {
+		resource.PartitionKey = &cosmosdb.ContainerPartitionKey{
+			Paths: &[]string{partitionkeypaths},
+			Kind:  pointer.To(cosmosdb.PartitionKindHash),
+		}
+
+		if partitionKeyVersion, ok := d.GetOk("partition_key_version"); ok {
+			resource.PartitionKey.Version = pointer.To(int64(partitionKeyVersion.(int)))
 		}
 	}
 
-	return nil
+	if keys := expandCosmosSQLContainerUniqueKeys(d.Get("unique_key").(*pluginsdk.Set)); keys != nil {
+		resource.UniqueKeyPolicy = &cosmosdb.UniqueKeyPolicy{
+			UniqueKeys: keys,
+		}
+	}
+
+	if analyticalStorageTTL, ok := d.GetOk("analytical_storage_ttl"); ok {
+		resource.AnalyticalStorageTtl = pointer.To(int64(analyticalStorageTTL.(int)))
+	}
+
+	if defaultTTL, hasTTL := d.GetOk("default_ttl"); hasTTL {
+		resource.DefaultTtl = pointer.To(int64(defaultTTL.(int)))
+	}
+
+	return &resource, nil
 }
 
-func expandCosmosSQLContainerUniqueKeys(s *pluginsdk.Set) *[]documentdb.UniqueKey {
+func expandCosmosSQLContainerUniqueKeys(s *pluginsdk.Set) *[]cosmosdb.UniqueKey {
 	i := s.List()
 	if len(i) == 0 || i[0] == nil {
 		return nil
 	}
 
-	keys := make([]documentdb.UniqueKey, 0)
+	keys := make([]cosmosdb.UniqueKey, 0)
 	for _, k := range i {
 		key := k.(map[string]interface{})
 
@@ -443,7 +529,7 @@ func expandCosmosSQLContainerUniqueKeys(s *pluginsdk.Set) *[]documentdb.UniqueKe
 			continue
 		}
 
-		keys = append(keys, documentdb.UniqueKey{
+		keys = append(keys, cosmosdb.UniqueKey{
 			Paths: utils.ExpandStringSlice(paths),
 		})
 	}
@@ -451,7 +537,7 @@ func expandCosmosSQLContainerUniqueKeys(s *pluginsdk.Set) *[]documentdb.UniqueKe
 	return &keys
 }
 
-func flattenCosmosSQLContainerUniqueKeys(keys *[]documentdb.UniqueKey) *[]map[string]interface{} {
+func flattenCosmosSQLContainerUniqueKeys(keys *[]cosmosdb.UniqueKey) *[]map[string]interface{} {
 	if keys == nil {
 		return nil
 	}
@@ -469,3 +555,166 @@ func flattenCosmosSQLContainerUniqueKeys(keys *[]documentdb.UniqueKey) *[]map[st
 
 	return &slice
 }
+
+func expandCosmosSQLContainerConflictResolutionPolicy(input []interface{}) *cosmosdb.ConflictResolutionPolicy {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	policy := &cosmosdb.ConflictResolutionPolicy{
+		Mode: pointer.To(cosmosdb.ConflictResolutionMode(v["mode"].(string))),
+	}
+
+	if conflictResolutionPath, ok := v["conflict_resolution_path"].(string); ok {
+		policy.ConflictResolutionPath = utils.String(conflictResolutionPath)
+	}
+
+	if conflictResolutionProcedure, ok := v["conflict_resolution_procedure"].(string); ok {
+		policy.ConflictResolutionProcedure = utils.String(conflictResolutionProcedure)
+	}
+
+	return policy
+}
+
+func flattenCosmosSQLContainerConflictResolutionPolicy(input *cosmosdb.ConflictResolutionPolicy) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"mode":                          string(pointer.From(input.Mode)),
+			"conflict_resolution_path":      pointer.From(input.ConflictResolutionPath),
+			"conflict_resolution_procedure": pointer.From(input.ConflictResolutionProcedure),
+		},
+	}
+}
+
+func expandCosmosSQLContainerComputedProperties(input []interface{}) *[]cosmosdb.ComputedProperty {
+	if len(input) == 0 {
+		return nil
+	}
+
+	properties := make([]cosmosdb.ComputedProperty, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		properties = append(properties, cosmosdb.ComputedProperty{
+			Name:  utils.String(v["name"].(string)),
+			Query: utils.String(v["query"].(string)),
+		})
+	}
+
+	return &properties
+}
+
+func flattenCosmosSQLContainerComputedProperties(input *[]cosmosdb.ComputedProperty) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		results = append(results, map[string]interface{}{
+			"name":  pointer.From(item.Name),
+			"query": pointer.From(item.Query),
+		})
+	}
+
+	return results
+}
+
+func expandCosmosSQLContainerFullTextPolicy(input []interface{}) *cosmosdb.FullTextPolicy {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	paths := make([]cosmosdb.FullTextPath, 0)
+	for _, item := range v["full_text_path"].([]interface{}) {
+		path := item.(map[string]interface{})
+
+		fullTextPath := cosmosdb.FullTextPath{
+			Path: path["path"].(string),
+		}
+		if language := path["language"].(string); language != "" {
+			fullTextPath.Language = utils.String(language)
+		}
+
+		paths = append(paths, fullTextPath)
+	}
+
+	return &cosmosdb.FullTextPolicy{
+		DefaultLanguage: utils.String(v["default_language"].(string)),
+		FullTextPaths:   &paths,
+	}
+}
+
+func flattenCosmosSQLContainerFullTextPolicy(input *cosmosdb.FullTextPolicy) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	paths := make([]interface{}, 0)
+	if input.FullTextPaths != nil {
+		for _, item := range *input.FullTextPaths {
+			paths = append(paths, map[string]interface{}{
+				"path":     item.Path,
+				"language": pointer.From(item.Language),
+			})
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"default_language": pointer.From(input.DefaultLanguage),
+			"full_text_path":   paths,
+		},
+	}
+}
+
+func expandCosmosSQLContainerVectorEmbeddingPolicy(input []interface{}) *cosmosdb.VectorEmbeddingPolicy {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	embeddings := make([]cosmosdb.VectorEmbedding, 0)
+	for _, item := range v["vector_embedding"].([]interface{}) {
+		embedding := item.(map[string]interface{})
+		embeddings = append(embeddings, cosmosdb.VectorEmbedding{
+			DataType:         cosmosdb.VectorDataType(embedding["data_type"].(string)),
+			Dimensions:       int64(embedding["dimensions"].(int)),
+			DistanceFunction: cosmosdb.DistanceFunction(embedding["distance_function"].(string)),
+			Path:             embedding["path"].(string),
+		})
+	}
+
+	return &cosmosdb.VectorEmbeddingPolicy{
+		VectorEmbeddings: &embeddings,
+	}
+}
+
+func flattenCosmosSQLContainerVectorEmbeddingPolicy(input *cosmosdb.VectorEmbeddingPolicy) []interface{} {
+	if input == nil || input.VectorEmbeddings == nil || len(*input.VectorEmbeddings) == 0 {
+		return []interface{}{}
+	}
+
+	embeddings := make([]interface{}, 0)
+	for _, item := range *input.VectorEmbeddings {
+		embeddings = append(embeddings, map[string]interface{}{
+			"path":              item.Path,
+			"data_type":         string(item.DataType),
+			"dimensions":        int(item.Dimensions),
+			"distance_function": string(item.DistanceFunction),
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"vector_embedding": embeddings,
+		},
+	}
+}
@@ -0,0 +1,75 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type DatabaseAccountManualFailoverId struct {
+	SubscriptionId      string
+	ResourceGroup       string
+	DatabaseAccountName string
+	ManualFailoverName  string
+}
+
+func NewDatabaseAccountManualFailoverID(subscriptionId, resourceGroup, databaseAccountName, manualFailoverName string) DatabaseAccountManualFailoverId {
+	return DatabaseAccountManualFailoverId{
+		SubscriptionId:      subscriptionId,
+		ResourceGroup:       resourceGroup,
+		DatabaseAccountName: databaseAccountName,
+		ManualFailoverName:  manualFailoverName,
+	}
+}
+
+func (id DatabaseAccountManualFailoverId) String() string {
+	segments := []string{
+		fmt.Sprintf("Manual Failover Name %q", id.ManualFailoverName),
+		fmt.Sprintf("Database Account Name %q", id.DatabaseAccountName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Database Account Manual Failover", segmentsStr)
+}
+
+func (id DatabaseAccountManualFailoverId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DocumentDB/databaseAccounts/%s/manualFailovers/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.DatabaseAccountName, id.ManualFailoverName)
+}
+
+// DatabaseAccountManualFailoverID parses a DatabaseAccountManualFailover ID into an DatabaseAccountManualFailoverId struct
+func DatabaseAccountManualFailoverID(input string) (*DatabaseAccountManualFailoverId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := DatabaseAccountManualFailoverId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.DatabaseAccountName, err = id.PopSegment("databaseAccounts"); err != nil {
+		return nil, err
+	}
+	if resourceId.ManualFailoverName, err = id.PopSegment("manualFailovers"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -22,6 +22,14 @@ func (r Registration) DataSources() []sdk.DataSource {
 
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
+		CosmosDbAccountManualFailoverResource{},
+		CosmosDbMongoRoleDefinitionResource{},
+		CosmosDbMongoUserDefinitionResource{},
+		CosmosDbPostgreSQLClusterResource{},
+		CosmosDbPostgreSQLCoordinatorConfigurationResource{},
+		CosmosDbPostgreSQLFirewallRuleResource{},
+		CosmosDbPostgreSQLNodeConfigurationResource{},
+		CosmosDbPostgreSQLRoleResource{},
 		CosmosDbSqlDedicatedGatewayResource{},
 	}
 }
@@ -0,0 +1,18 @@
+package clusters
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type ClustersClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewClustersClientWithBaseURI(endpoint string) ClustersClient {
+	return ClustersClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,12 @@
+package clusters
+
+import "fmt"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+const defaultApiVersion = "2022-11-08"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/clusters/%s", defaultApiVersion)
+}
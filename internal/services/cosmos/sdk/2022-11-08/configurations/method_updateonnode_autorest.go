@@ -0,0 +1,80 @@
+package configurations
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type UpdateOnNodeOperationResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// UpdateOnNode ...
+func (c ConfigurationsClient) UpdateOnNode(ctx context.Context, id NodeConfigurationId, input ServerConfiguration) (result UpdateOnNodeOperationResponse, err error) {
+	req, err := c.preparerForUpdateOnNode(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "configurations.ConfigurationsClient", "UpdateOnNode", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForUpdateOnNode(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "configurations.ConfigurationsClient", "UpdateOnNode", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// UpdateOnNodeThenPoll performs UpdateOnNode then polls until it's completed
+func (c ConfigurationsClient) UpdateOnNodeThenPoll(ctx context.Context, id NodeConfigurationId, input ServerConfiguration) error {
+	result, err := c.UpdateOnNode(ctx, id, input)
+	if err != nil {
+		return fmt.Errorf("performing UpdateOnNode: %+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after UpdateOnNode: %+v", err)
+	}
+
+	return nil
+}
+
+// preparerForUpdateOnNode prepares the UpdateOnNode request.
+func (c ConfigurationsClient) preparerForUpdateOnNode(ctx context.Context, id NodeConfigurationId, input ServerConfiguration) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForUpdateOnNode sends the UpdateOnNode request. The method will close the
+// http.Response Body if it receives an error.
+func (c ConfigurationsClient) senderForUpdateOnNode(ctx context.Context, req *http.Request) (future UpdateOnNodeOperationResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+
+	future.HttpResponse = resp
+	future.Poller, err = polling.NewPollerFromResponse(ctx, resp, c.Client, req.Method)
+	return
+}
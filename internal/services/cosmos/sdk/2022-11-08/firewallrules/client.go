@@ -0,0 +1,18 @@
+package firewallrules
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type FirewallRulesClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewFirewallRulesClientWithBaseURI(endpoint string) FirewallRulesClient {
+	return FirewallRulesClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,9 @@
+package roles
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type RoleProperties struct {
+	Password          string             `json:"password"`
+	ProvisioningState *ProvisioningState `json:"provisioningState,omitempty"`
+}
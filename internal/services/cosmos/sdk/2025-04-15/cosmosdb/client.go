@@ -0,0 +1,18 @@
+package cosmosdb
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type CosmosDBClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewCosmosDBClientWithBaseURI(endpoint string) CosmosDBClient {
+	return CosmosDBClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
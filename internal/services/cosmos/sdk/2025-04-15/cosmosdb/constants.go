@@ -0,0 +1,251 @@
+package cosmosdb
+
+import "strings"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type CompositePathSortOrder string
+
+const (
+	CompositePathSortOrderAscending  CompositePathSortOrder = "ascending"
+	CompositePathSortOrderDescending CompositePathSortOrder = "descending"
+)
+
+func PossibleValuesForCompositePathSortOrder() []string {
+	return []string{
+		string(CompositePathSortOrderAscending),
+		string(CompositePathSortOrderDescending),
+	}
+}
+
+func parseCompositePathSortOrder(input string) (*CompositePathSortOrder, error) {
+	vals := map[string]CompositePathSortOrder{
+		"ascending":  CompositePathSortOrderAscending,
+		"descending": CompositePathSortOrderDescending,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := CompositePathSortOrder(input)
+	return &out, nil
+}
+
+type ConflictResolutionMode string
+
+const (
+	ConflictResolutionModeCustom         ConflictResolutionMode = "Custom"
+	ConflictResolutionModeLastWriterWins ConflictResolutionMode = "LastWriterWins"
+)
+
+func PossibleValuesForConflictResolutionMode() []string {
+	return []string{
+		string(ConflictResolutionModeCustom),
+		string(ConflictResolutionModeLastWriterWins),
+	}
+}
+
+func parseConflictResolutionMode(input string) (*ConflictResolutionMode, error) {
+	vals := map[string]ConflictResolutionMode{
+		"custom":         ConflictResolutionModeCustom,
+		"lastwriterwins": ConflictResolutionModeLastWriterWins,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := ConflictResolutionMode(input)
+	return &out, nil
+}
+
+type DistanceFunction string
+
+const (
+	DistanceFunctionCosine     DistanceFunction = "cosine"
+	DistanceFunctionDotproduct DistanceFunction = "dotproduct"
+	DistanceFunctionEuclidean  DistanceFunction = "euclidean"
+)
+
+func PossibleValuesForDistanceFunction() []string {
+	return []string{
+		string(DistanceFunctionCosine),
+		string(DistanceFunctionDotproduct),
+		string(DistanceFunctionEuclidean),
+	}
+}
+
+func parseDistanceFunction(input string) (*DistanceFunction, error) {
+	vals := map[string]DistanceFunction{
+		"cosine":     DistanceFunctionCosine,
+		"dotproduct": DistanceFunctionDotproduct,
+		"euclidean":  DistanceFunctionEuclidean,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := DistanceFunction(input)
+	return &out, nil
+}
+
+type IndexingMode string
+
+const (
+	IndexingModeConsistent IndexingMode = "consistent"
+	IndexingModeLazy       IndexingMode = "lazy"
+	IndexingModeNone       IndexingMode = "none"
+)
+
+func PossibleValuesForIndexingMode() []string {
+	return []string{
+		string(IndexingModeConsistent),
+		string(IndexingModeLazy),
+		string(IndexingModeNone),
+	}
+}
+
+func parseIndexingMode(input string) (*IndexingMode, error) {
+	vals := map[string]IndexingMode{
+		"consistent": IndexingModeConsistent,
+		"lazy":       IndexingModeLazy,
+		"none":       IndexingModeNone,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := IndexingMode(input)
+	return &out, nil
+}
+
+type PartitionKind string
+
+const (
+	PartitionKindHash      PartitionKind = "Hash"
+	PartitionKindMultiHash PartitionKind = "MultiHash"
+	PartitionKindRange     PartitionKind = "Range"
+)
+
+func PossibleValuesForPartitionKind() []string {
+	return []string{
+		string(PartitionKindHash),
+		string(PartitionKindMultiHash),
+		string(PartitionKindRange),
+	}
+}
+
+func parsePartitionKind(input string) (*PartitionKind, error) {
+	vals := map[string]PartitionKind{
+		"hash":      PartitionKindHash,
+		"multihash": PartitionKindMultiHash,
+		"range":     PartitionKindRange,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := PartitionKind(input)
+	return &out, nil
+}
+
+type SpatialType string
+
+const (
+	SpatialTypeLineString   SpatialType = "LineString"
+	SpatialTypeMultiPolygon SpatialType = "MultiPolygon"
+	SpatialTypePoint        SpatialType = "Point"
+	SpatialTypePolygon      SpatialType = "Polygon"
+)
+
+func PossibleValuesForSpatialType() []string {
+	return []string{
+		string(SpatialTypeLineString),
+		string(SpatialTypeMultiPolygon),
+		string(SpatialTypePoint),
+		string(SpatialTypePolygon),
+	}
+}
+
+func parseSpatialType(input string) (*SpatialType, error) {
+	vals := map[string]SpatialType{
+		"linestring":   SpatialTypeLineString,
+		"multipolygon": SpatialTypeMultiPolygon,
+		"point":        SpatialTypePoint,
+		"polygon":      SpatialTypePolygon,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := SpatialType(input)
+	return &out, nil
+}
+
+type VectorDataType string
+
+const (
+	VectorDataTypeFloat32 VectorDataType = "float32"
+	VectorDataTypeInt8    VectorDataType = "int8"
+	VectorDataTypeUint8   VectorDataType = "uint8"
+)
+
+func PossibleValuesForVectorDataType() []string {
+	return []string{
+		string(VectorDataTypeFloat32),
+		string(VectorDataTypeInt8),
+		string(VectorDataTypeUint8),
+	}
+}
+
+func parseVectorDataType(input string) (*VectorDataType, error) {
+	vals := map[string]VectorDataType{
+		"float32": VectorDataTypeFloat32,
+		"int8":    VectorDataTypeInt8,
+		"uint8":   VectorDataTypeUint8,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := VectorDataType(input)
+	return &out, nil
+}
+
+type VectorIndexType string
+
+const (
+	VectorIndexTypeDiskANN       VectorIndexType = "diskANN"
+	VectorIndexTypeFlat          VectorIndexType = "flat"
+	VectorIndexTypeQuantizedFlat VectorIndexType = "quantizedFlat"
+)
+
+func PossibleValuesForVectorIndexType() []string {
+	return []string{
+		string(VectorIndexTypeDiskANN),
+		string(VectorIndexTypeFlat),
+		string(VectorIndexTypeQuantizedFlat),
+	}
+}
+
+func parseVectorIndexType(input string) (*VectorIndexType, error) {
+	vals := map[string]VectorIndexType{
+		"diskann":       VectorIndexTypeDiskANN,
+		"flat":          VectorIndexTypeFlat,
+		"quantizedflat": VectorIndexTypeQuantizedFlat,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := VectorIndexType(input)
+	return &out, nil
+}
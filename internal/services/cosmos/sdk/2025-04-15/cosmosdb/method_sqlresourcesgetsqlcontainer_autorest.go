@@ -0,0 +1,68 @@
+package cosmosdb
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type SqlResourcesGetSqlContainerOperationResponse struct {
+	HttpResponse *http.Response
+	Model        *SqlContainerGetResults
+}
+
+// SqlResourcesGetSqlContainer ...
+func (c CosmosDBClient) SqlResourcesGetSqlContainer(ctx context.Context, id SqlContainerId) (result SqlResourcesGetSqlContainerOperationResponse, err error) {
+	req, err := c.preparerForSqlResourcesGetSqlContainer(ctx, id)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "cosmosdb.CosmosDBClient", "SqlResourcesGetSqlContainer", nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "cosmosdb.CosmosDBClient", "SqlResourcesGetSqlContainer", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderForSqlResourcesGetSqlContainer(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "cosmosdb.CosmosDBClient", "SqlResourcesGetSqlContainer", result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// preparerForSqlResourcesGetSqlContainer prepares the SqlResourcesGetSqlContainer request.
+func (c CosmosDBClient) preparerForSqlResourcesGetSqlContainer(ctx context.Context, id SqlContainerId) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsGet(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(id.ID()),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// responderForSqlResourcesGetSqlContainer handles the response to the SqlResourcesGetSqlContainer request. The method always
+// closes the http.Response Body.
+func (c CosmosDBClient) responderForSqlResourcesGetSqlContainer(resp *http.Response) (result SqlResourcesGetSqlContainerOperationResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+
+	return
+}
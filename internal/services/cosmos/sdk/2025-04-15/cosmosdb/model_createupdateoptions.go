@@ -0,0 +1,9 @@
+package cosmosdb
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type CreateUpdateOptions struct {
+	AutoscaleSettings *AutoscaleSettings `json:"autoscaleSettings,omitempty"`
+	Throughput        *int64             `json:"throughput,omitempty"`
+}
@@ -0,0 +1,8 @@
+package cosmosdb
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type SqlContainerGetProperties struct {
+	Resource *SqlContainerGetPropertiesResource `json:"resource,omitempty"`
+}
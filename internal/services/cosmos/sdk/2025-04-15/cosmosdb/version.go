@@ -0,0 +1,12 @@
+package cosmosdb
+
+import "fmt"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+const defaultApiVersion = "2025-04-15"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/cosmosdb/%s", defaultApiVersion)
+}
@@ -0,0 +1,21 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+func PostgresqlClusterName(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	// Portal: The name must be 3 - 40 characters long, contain only lowercase letters, numbers and hyphens and can't start or end with a hyphen
+	if matched := regexp.MustCompile("^[a-z0-9]([-a-z0-9]{1,38})[a-z0-9]$").Match([]byte(value)); !matched {
+		errors = append(errors, fmt.Errorf("%s must be 3 - 40 characters long, contain only lowercase letters, numbers and hyphens and can't start or end with a hyphen", k))
+	}
+
+	return warnings, errors
+}
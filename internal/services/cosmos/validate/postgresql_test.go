@@ -0,0 +1,54 @@
+package validate
+
+import "testing"
+
+func TestPostgresqlClusterName(t *testing.T) {
+	cases := []struct {
+		Value  string
+		Errors int
+	}{
+		{
+			Value:  "foo-bar",
+			Errors: 0,
+		},
+		{
+			Value:  "foo",
+			Errors: 0,
+		},
+		{
+			Value:  "fo",
+			Errors: 1,
+		},
+		{
+			Value:  "-foo",
+			Errors: 1,
+		},
+		{
+			Value:  "foo-",
+			Errors: 1,
+		},
+		{
+			Value:  "Foo",
+			Errors: 1,
+		},
+		{
+			Value:  "foo_bar",
+			Errors: 1,
+		},
+		{
+			Value:  "foo-bar-foo-bar-foo-bar-foo-bar-foo-bar-",
+			Errors: 1,
+		},
+		{
+			Value:  "foo-bar-foo-bar-foo-bar-foo-bar-foo-bar1",
+			Errors: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := PostgresqlClusterName(tc.Value, "name")
+		if len(errors) != tc.Errors {
+			t.Fatalf("Expected PostgresqlClusterName to trigger '%d' errors for '%s' - got '%d'", tc.Errors, tc.Value, len(errors))
+		}
+	}
+}
@@ -1,19 +1,29 @@
 package client
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2021-10-01/keyvault" // nolint: staticcheck
 	keyvaultmgmt "github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	keyvaultdataplane "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/sdk/v7.4/keyvault"
 )
 
 type Client struct {
-	ManagedHsmClient *keyvault.ManagedHsmsClient
-	ManagementClient *keyvaultmgmt.BaseClient
-	VaultsClient     *keyvault.VaultsClient
-	options          *common.ClientOptions
+	KeyRotationClient *keyvaultdataplane.BaseClient
+	ManagedHsmClient  *keyvault.ManagedHsmsClient
+	ManagementClient  *keyvaultmgmt.BaseClient
+	VaultsClient      *keyvault.VaultsClient
+	options           *common.ClientOptions
 }
 
 func NewClient(o *common.ClientOptions) *Client {
+	keyRotationClient := keyvaultdataplane.New()
+	o.ConfigureClient(&keyRotationClient.Client, o.KeyVaultAuthorizer)
+
 	managedHsmClient := keyvault.NewManagedHsmsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&managedHsmClient.Client, o.ResourceManagerAuthorizer)
 
@@ -24,10 +34,11 @@ func NewClient(o *common.ClientOptions) *Client {
 	o.ConfigureClient(&vaultsClient.Client, o.ResourceManagerAuthorizer)
 
 	return &Client{
-		ManagedHsmClient: &managedHsmClient,
-		ManagementClient: &managementClient,
-		VaultsClient:     &vaultsClient,
-		options:          o,
+		KeyRotationClient: &keyRotationClient,
+		ManagedHsmClient:  &managedHsmClient,
+		ManagementClient:  &managementClient,
+		VaultsClient:      &vaultsClient,
+		options:           o,
 	}
 }
 
@@ -36,3 +47,62 @@ func (client Client) KeyVaultClientForSubscription(subscriptionId string) *keyva
 	client.options.ConfigureClient(&vaultsClient.Client, client.options.ResourceManagerAuthorizer)
 	return &vaultsClient
 }
+
+// ManagedHSMKeysClient returns a client for managing Keys within a Managed HSM
+func (client Client) ManagedHSMKeysClient() (*keyvaultmgmt.BaseClient, error) {
+	authorizer, err := client.managedHSMAuthorizer()
+	if err != nil {
+		return nil, err
+	}
+
+	keysClient := keyvaultmgmt.New()
+	client.options.ConfigureClient(&keysClient.Client, authorizer)
+	return &keysClient, nil
+}
+
+// ManagedHSMRoleAssignmentsClient returns a client for managing Role Assignments within a Managed HSM
+func (client Client) ManagedHSMRoleAssignmentsClient() (*keyvaultdataplane.RoleAssignmentsClient, error) {
+	authorizer, err := client.managedHSMAuthorizer()
+	if err != nil {
+		return nil, err
+	}
+
+	roleAssignmentsClient := keyvaultdataplane.NewRoleAssignmentsClient()
+	client.options.ConfigureClient(&roleAssignmentsClient.Client, authorizer)
+	return &roleAssignmentsClient, nil
+}
+
+// ManagedHSMRoleDefinitionsClient returns a client for managing Role Definitions within a Managed HSM
+func (client Client) ManagedHSMRoleDefinitionsClient() (*keyvaultdataplane.RoleDefinitionsClient, error) {
+	authorizer, err := client.managedHSMAuthorizer()
+	if err != nil {
+		return nil, err
+	}
+
+	roleDefinitionsClient := keyvaultdataplane.NewRoleDefinitionsClient()
+	client.options.ConfigureClient(&roleDefinitionsClient.Client, authorizer)
+	return &roleDefinitionsClient, nil
+}
+
+// ManagedHSMSecurityDomainClient returns a client for downloading the Security Domain of a Managed HSM
+func (client Client) ManagedHSMSecurityDomainClient() (*keyvaultdataplane.HSMSecurityDomainClient, error) {
+	authorizer, err := client.managedHSMAuthorizer()
+	if err != nil {
+		return nil, err
+	}
+
+	securityDomainClient := keyvaultdataplane.NewHSMSecurityDomainClient()
+	client.options.ConfigureClient(&securityDomainClient.Client, authorizer)
+	return &securityDomainClient, nil
+}
+
+// managedHSMAuthorizer returns an Authorizer for the Managed HSM data plane, which uses a different
+// audience to the Key Vault data plane
+func (client Client) managedHSMAuthorizer() (autorest.Authorizer, error) {
+	endpoint := client.options.Environment.ManagedHSMEndpoint
+	if endpoint == "" || endpoint == azure.NotAvailable {
+		return nil, fmt.Errorf("Managed HSM is not available in the %q environment", client.options.Environment.Name)
+	}
+
+	return client.options.TokenFunc(strings.TrimSuffix(endpoint, "/"))
+}
@@ -17,8 +17,10 @@ import (
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	keyvaultrotation "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/sdk/v7.4/keyvault"
 	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -48,6 +50,18 @@ func resourceKeyVaultKey() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+			// rotating the key creates a new version, so the version specific attributes will change
+			if d.Id() != "" && d.HasChange("rotate_when_changed") {
+				for _, key := range []string{"latest_version", "n", "e", "x", "y", "public_key_pem", "public_key_openssh"} {
+					if err := d.SetNewComputed(key); err != nil {
+						return err
+					}
+				}
+			}
+			return nil
+		}),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -137,6 +151,63 @@ func resourceKeyVaultKey() *pluginsdk.Resource {
 				ValidateFunc: validation.IsRFC3339Time,
 			},
 
+			"rotation_policy": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"expire_after": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.ISO8601DurationBetween("P28D", "P100Y"),
+						},
+
+						"notify_before_expiry": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.ISO8601Duration,
+							RequiredWith: []string{"rotation_policy.0.expire_after"},
+						},
+
+						"automatic": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"time_after_creation": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: validate.ISO8601Duration,
+										ExactlyOneOf: []string{
+											"rotation_policy.0.automatic.0.time_after_creation",
+											"rotation_policy.0.automatic.0.time_before_expiry",
+										},
+									},
+
+									"time_before_expiry": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: validate.ISO8601Duration,
+										ExactlyOneOf: []string{
+											"rotation_policy.0.automatic.0.time_after_creation",
+											"rotation_policy.0.automatic.0.time_before_expiry",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			"rotate_when_changed": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
 			// Computed
 			"version": {
 				Type:     pluginsdk.TypeString,
@@ -148,6 +219,11 @@ func resourceKeyVaultKey() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"latest_version": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"n": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -291,6 +367,14 @@ func resourceKeyVaultKeyCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 		}
 	}
 
+	if v, ok := d.GetOk("rotation_policy"); ok {
+		rotationClient := meta.(*clients.Client).KeyVault.KeyRotationClient
+		policy := expandKeyVaultKeyRotationPolicy(v.([]interface{}))
+		if _, err := rotationClient.UpdateKeyRotationPolicy(ctx, *keyVaultBaseUri, name, policy); err != nil {
+			return fmt.Errorf("setting Rotation Policy for Key %q (Key Vault %q): %+v", name, *keyVaultBaseUri, err)
+		}
+	}
+
 	// "" indicates the latest version
 	read, err := client.GetKey(ctx, *keyVaultBaseUri, name, "")
 	if err != nil {
@@ -364,6 +448,21 @@ func resourceKeyVaultKeyUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		return err
 	}
 
+	rotationClient := meta.(*clients.Client).KeyVault.KeyRotationClient
+	if d.HasChange("rotation_policy") {
+		policy := expandKeyVaultKeyRotationPolicy(d.Get("rotation_policy").([]interface{}))
+		if _, err := rotationClient.UpdateKeyRotationPolicy(ctx, id.KeyVaultBaseUrl, id.Name, policy); err != nil {
+			return fmt.Errorf("updating Rotation Policy for Key %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+		}
+	}
+
+	// the ID of the resource remains the originally created version, the new version is exposed via `latest_version`
+	if d.HasChange("rotate_when_changed") {
+		if _, err := rotationClient.RotateKey(ctx, id.KeyVaultBaseUrl, id.Name); err != nil {
+			return fmt.Errorf("rotating Key %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+		}
+	}
+
 	return resourceKeyVaultKeyRead(d, meta)
 }
 
@@ -449,8 +548,19 @@ func resourceKeyVaultKeyRead(d *pluginsdk.ResourceData, meta interface{}) error
 		}
 	}
 
+	// the latest version is retrieved above, which differs from the version in the ID once the Key has been rotated
+	latestVersion := id.Version
+	if key := resp.Key; key != nil && key.Kid != nil {
+		kid, err := parse.ParseNestedItemID(*key.Kid)
+		if err != nil {
+			return err
+		}
+		latestVersion = kid.Version
+	}
+
 	// Computed
 	d.Set("version", id.Version)
+	d.Set("latest_version", latestVersion)
 	d.Set("versionless_id", id.VersionlessID())
 	if key := resp.Key; key != nil {
 		if key.Kty == keyvault.RSA || key.Kty == keyvault.RSAHSM {
@@ -504,6 +614,18 @@ func resourceKeyVaultKeyRead(d *pluginsdk.ResourceData, meta interface{}) error
 	d.Set("resource_id", parse.NewKeyID(keyVaultId.SubscriptionId, keyVaultId.ResourceGroup, keyVaultId.Name, id.Name, id.Version).ID())
 	d.Set("resource_versionless_id", parse.NewKeyVersionlessID(keyVaultId.SubscriptionId, keyVaultId.ResourceGroup, keyVaultId.Name, id.Name).ID())
 
+	rotationClient := meta.(*clients.Client).KeyVault.KeyRotationClient
+	policy, err := rotationClient.GetKeyRotationPolicy(ctx, id.KeyVaultBaseUrl, id.Name)
+	if err != nil {
+		// the `GetRotationPolicy` permission isn't granted to existing access policies by default
+		if !utils.ResponseWasForbidden(policy.Response) {
+			return fmt.Errorf("retrieving Rotation Policy for Key %q (Key Vault %q): %+v", id.Name, id.KeyVaultBaseUrl, err)
+		}
+		log.Printf("[DEBUG] Unable to retrieve the Rotation Policy for Key %q in Key Vault at URI %q - missing the `GetRotationPolicy` permission", id.Name, id.KeyVaultBaseUrl)
+	} else if err := d.Set("rotation_policy", flattenKeyVaultKeyRotationPolicy(policy)); err != nil {
+		return fmt.Errorf("setting `rotation_policy`: %+v", err)
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
@@ -608,6 +730,106 @@ func flattenKeyVaultKeyOptions(input *[]string) []interface{} {
 	return results
 }
 
+func expandKeyVaultKeyRotationPolicy(input []interface{}) keyvaultrotation.KeyRotationPolicy {
+	// removing the policy is done by sending an empty one
+	output := keyvaultrotation.KeyRotationPolicy{
+		LifetimeActions: &[]keyvaultrotation.LifetimeActions{},
+		Attributes:      &keyvaultrotation.KeyRotationPolicyAttributes{},
+	}
+	if len(input) == 0 || input[0] == nil {
+		return output
+	}
+
+	policy := input[0].(map[string]interface{})
+	if v := policy["expire_after"].(string); v != "" {
+		output.Attributes.ExpiryTime = utils.String(v)
+	}
+
+	lifetimeActions := make([]keyvaultrotation.LifetimeActions, 0)
+	if v := policy["notify_before_expiry"].(string); v != "" {
+		lifetimeActions = append(lifetimeActions, keyvaultrotation.LifetimeActions{
+			Action: &keyvaultrotation.LifetimeActionsType{
+				Type: keyvaultrotation.KeyRotationPolicyActionNotify,
+			},
+			Trigger: &keyvaultrotation.LifetimeActionsTrigger{
+				TimeBeforeExpiry: utils.String(v),
+			},
+		})
+	}
+
+	if automatic := policy["automatic"].([]interface{}); len(automatic) > 0 && automatic[0] != nil {
+		raw := automatic[0].(map[string]interface{})
+		trigger := keyvaultrotation.LifetimeActionsTrigger{}
+		if v := raw["time_after_creation"].(string); v != "" {
+			trigger.TimeAfterCreate = utils.String(v)
+		}
+		if v := raw["time_before_expiry"].(string); v != "" {
+			trigger.TimeBeforeExpiry = utils.String(v)
+		}
+
+		lifetimeActions = append(lifetimeActions, keyvaultrotation.LifetimeActions{
+			Action: &keyvaultrotation.LifetimeActionsType{
+				Type: keyvaultrotation.KeyRotationPolicyActionRotate,
+			},
+			Trigger: &trigger,
+		})
+	}
+	output.LifetimeActions = &lifetimeActions
+
+	return output
+}
+
+func flattenKeyVaultKeyRotationPolicy(input keyvaultrotation.KeyRotationPolicy) []interface{} {
+	expireAfter := ""
+	if input.Attributes != nil && input.Attributes.ExpiryTime != nil {
+		expireAfter = *input.Attributes.ExpiryTime
+	}
+
+	notifyBeforeExpiry := ""
+	automatic := make([]interface{}, 0)
+	if input.LifetimeActions != nil {
+		for _, action := range *input.LifetimeActions {
+			if action.Action == nil || action.Trigger == nil {
+				continue
+			}
+
+			switch action.Action.Type {
+			case keyvaultrotation.KeyRotationPolicyActionNotify:
+				if action.Trigger.TimeBeforeExpiry != nil {
+					notifyBeforeExpiry = *action.Trigger.TimeBeforeExpiry
+				}
+			case keyvaultrotation.KeyRotationPolicyActionRotate:
+				timeAfterCreation := ""
+				if action.Trigger.TimeAfterCreate != nil {
+					timeAfterCreation = *action.Trigger.TimeAfterCreate
+				}
+				timeBeforeExpiry := ""
+				if action.Trigger.TimeBeforeExpiry != nil {
+					timeBeforeExpiry = *action.Trigger.TimeBeforeExpiry
+				}
+				automatic = append(automatic, map[string]interface{}{
+					"time_after_creation": timeAfterCreation,
+					"time_before_expiry":  timeBeforeExpiry,
+				})
+			}
+		}
+	}
+
+	// Key Vault returns a default policy (notifying 30 days before expiry) for keys without a rotation policy
+	// which we treat as the policy not being set
+	if expireAfter == "" && len(automatic) == 0 {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"expire_after":         expireAfter,
+			"notify_before_expiry": notifyBeforeExpiry,
+			"automatic":            automatic,
+		},
+	}
+}
+
 // Credit to Hashicorp modified from https://github.com/hashicorp/terraform-provider-tls/blob/v3.1.0/internal/provider/util.go#L79-L105
 func readPublicKey(d *pluginsdk.ResourceData, pubKey interface{}) error {
 	pubKeyBytes, err := x509.MarshalPKIXPublicKey(pubKey)
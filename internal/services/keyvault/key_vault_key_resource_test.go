@@ -190,6 +190,68 @@ func TestAccKeyVaultKey_update(t *testing.T) {
 	})
 }
 
+func TestAccKeyVaultKey_rotationPolicy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_key", "test")
+	r := KeyVaultKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basicRSA(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.rotationPolicy(data, "P90D", "P30D", "P29D"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rotation_policy.0.expire_after").HasValue("P90D"),
+			),
+		},
+		data.ImportStep("rotate_when_changed"),
+		{
+			Config: r.rotationPolicy(data, "P180D", "P60D", "P30D"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rotation_policy.0.expire_after").HasValue("P180D"),
+			),
+		},
+		data.ImportStep("rotate_when_changed"),
+		{
+			Config: r.basicRSA(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rotation_policy.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccKeyVaultKey_rotateWhenChanged(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_key", "test")
+	r := KeyVaultKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.rotateWhenChanged(data, "first"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("rotate_when_changed"),
+		{
+			Config: r.rotateWhenChanged(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("latest_version").Exists(),
+			),
+		},
+		data.ImportStep("rotate_when_changed"),
+	})
+}
+
 func TestAccKeyVaultKey_updatedExternally(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_key_vault_key", "test")
 	r := KeyVaultKeyResource{}
@@ -554,6 +616,68 @@ resource "azurerm_key_vault_key" "test" {
 `, r.templateStandard(data), data.RandomString)
 }
 
+func (r KeyVaultKeyResource) rotationPolicy(data acceptance.TestData, expireAfter, timeAfterCreation, notifyBeforeExpiry string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_key_vault_key" "test" {
+  name         = "key-%s"
+  key_vault_id = azurerm_key_vault.test.id
+  key_type     = "RSA"
+  key_size     = 2048
+
+  key_opts = [
+    "decrypt",
+    "encrypt",
+    "sign",
+    "unwrapKey",
+    "verify",
+    "wrapKey",
+  ]
+
+  rotation_policy {
+    expire_after         = "%s"
+    notify_before_expiry = "%s"
+
+    automatic {
+      time_after_creation = "%s"
+    }
+  }
+}
+`, r.templateStandard(data), data.RandomString, expireAfter, notifyBeforeExpiry, timeAfterCreation)
+}
+
+func (r KeyVaultKeyResource) rotateWhenChanged(data acceptance.TestData, trigger string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_key_vault_key" "test" {
+  name                = "key-%s"
+  key_vault_id        = azurerm_key_vault.test.id
+  key_type            = "RSA"
+  key_size            = 2048
+  rotate_when_changed = "%s"
+
+  key_opts = [
+    "decrypt",
+    "encrypt",
+    "sign",
+    "unwrapKey",
+    "verify",
+    "wrapKey",
+  ]
+}
+`, r.templateStandard(data), data.RandomString, trigger)
+}
+
 func (r KeyVaultKeyResource) curveEC(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -800,6 +924,9 @@ resource "azurerm_key_vault" "test" {
       "Purge",
       "Recover",
       "Update",
+      "GetRotationPolicy",
+      "SetRotationPolicy",
+      "Rotate",
     ]
 
     secret_permissions = [
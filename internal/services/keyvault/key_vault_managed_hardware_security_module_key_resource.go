@@ -0,0 +1,364 @@
+package keyvault
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KeyVaultManagedHardwareSecurityModuleKeyModel struct {
+	Name           string            `tfschema:"name"`
+	VaultBaseUrl   string            `tfschema:"vault_base_url"`
+	KeyType        string            `tfschema:"key_type"`
+	KeyOpts        []string          `tfschema:"key_opts"`
+	KeySize        int               `tfschema:"key_size"`
+	Curve          string            `tfschema:"curve"`
+	NotBeforeDate  string            `tfschema:"not_before_date"`
+	ExpirationDate string            `tfschema:"expiration_date"`
+	Tags           map[string]string `tfschema:"tags"`
+	VersionedId    string            `tfschema:"versioned_id"`
+}
+
+type KeyVaultManagedHardwareSecurityModuleKeyResource struct{}
+
+var _ sdk.ResourceWithUpdate = KeyVaultManagedHardwareSecurityModuleKeyResource{}
+
+func (r KeyVaultManagedHardwareSecurityModuleKeyResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.NestedItemName,
+		},
+
+		"vault_base_url": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateFunc:     validation.IsURLWithHTTPS,
+			DiffSuppressFunc: suppress.DiffSuppressManagedHSMBaseUrl,
+		},
+
+		"key_type": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(keyvault.ECHSM),
+				string(keyvault.RSAHSM),
+			}, false),
+		},
+
+		"key_opts": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(keyvault.Decrypt),
+					string(keyvault.Encrypt),
+					string(keyvault.Import),
+					string(keyvault.Sign),
+					string(keyvault.UnwrapKey),
+					string(keyvault.Verify),
+					string(keyvault.WrapKey),
+				}, false),
+			},
+		},
+
+		"key_size": {
+			Type:          pluginsdk.TypeInt,
+			Optional:      true,
+			ForceNew:      true,
+			ValidateFunc:  validation.IntInSlice([]int{2048, 3072, 4096}),
+			ConflictsWith: []string{"curve"},
+		},
+
+		"curve": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(keyvault.P256),
+				string(keyvault.P256K),
+				string(keyvault.P384),
+				string(keyvault.P521),
+			}, false),
+			ConflictsWith: []string{"key_size"},
+		},
+
+		"not_before_date": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+
+		"expiration_date": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.IsRFC3339Time,
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleKeyResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"versioned_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleKeyResource) ResourceType() string {
+	return "azurerm_key_vault_managed_hardware_security_module_key"
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleKeyResource) ModelObject() interface{} {
+	return &KeyVaultManagedHardwareSecurityModuleKeyModel{}
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleKeyResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.VersionlessNestedItemId
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleKeyResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client, err := metadata.Client.KeyVault.ManagedHSMKeysClient()
+			if err != nil {
+				return fmt.Errorf("building Managed HSM Keys client: %+v", err)
+			}
+
+			var model KeyVaultManagedHardwareSecurityModuleKeyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := parse.NewNestedItemID(model.VaultBaseUrl, "keys", model.Name, "")
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.GetKey(ctx, id.KeyVaultBaseUrl, id.Name, "")
+			if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := keyvault.KeyCreateParameters{
+				Kty:    keyvault.JSONWebKeyType(model.KeyType),
+				KeyOps: expandKeyVaultManagedHardwareSecurityModuleKeyOptions(model.KeyOpts),
+				KeyAttributes: &keyvault.KeyAttributes{
+					Enabled: utils.Bool(true),
+				},
+				Tags: tags.FromTypedObject(model.Tags),
+			}
+
+			switch parameters.Kty {
+			case keyvault.ECHSM:
+				if model.Curve == "" {
+					return fmt.Errorf("`curve` is required when creating an `EC-HSM` key")
+				}
+				parameters.Curve = keyvault.JSONWebKeyCurveName(model.Curve)
+			case keyvault.RSAHSM:
+				if model.KeySize == 0 {
+					return fmt.Errorf("`key_size` is required when creating an `RSA-HSM` key")
+				}
+				parameters.KeySize = utils.Int32(int32(model.KeySize))
+			}
+
+			if model.NotBeforeDate != "" {
+				notBeforeDate, _ := time.Parse(time.RFC3339, model.NotBeforeDate) // validated by schema
+				notBeforeUnixTime := date.UnixTime(notBeforeDate)
+				parameters.KeyAttributes.NotBefore = &notBeforeUnixTime
+			}
+
+			if model.ExpirationDate != "" {
+				expirationDate, _ := time.Parse(time.RFC3339, model.ExpirationDate) // validated by schema
+				expirationUnixTime := date.UnixTime(expirationDate)
+				parameters.KeyAttributes.Expires = &expirationUnixTime
+			}
+
+			if _, err := client.CreateKey(ctx, id.KeyVaultBaseUrl, id.Name, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleKeyResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client, err := metadata.Client.KeyVault.ManagedHSMKeysClient()
+			if err != nil {
+				return fmt.Errorf("building Managed HSM Keys client: %+v", err)
+			}
+
+			id, err := parse.ParseOptionallyVersionedNestedItemID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.GetKey(ctx, id.KeyVaultBaseUrl, id.Name, "")
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := KeyVaultManagedHardwareSecurityModuleKeyModel{
+				Name:         id.Name,
+				VaultBaseUrl: id.KeyVaultBaseUrl,
+				Tags:         tags.ToTypedObject(resp.Tags),
+			}
+
+			if key := resp.Key; key != nil {
+				state.KeyType = string(key.Kty)
+				state.KeyOpts = flattenKeyVaultManagedHardwareSecurityModuleKeyOptions(key.KeyOps)
+				state.Curve = string(key.Crv)
+				state.VersionedId = utils.NormalizeNilableString(key.Kid)
+
+				if key.N != nil {
+					nBytes, err := base64.RawURLEncoding.DecodeString(*key.N)
+					if err != nil {
+						return fmt.Errorf("decoding N: %+v", err)
+					}
+					state.KeySize = len(nBytes) * 8
+				}
+			}
+
+			if attributes := resp.Attributes; attributes != nil {
+				if v := attributes.NotBefore; v != nil {
+					state.NotBeforeDate = time.Time(*v).Format(time.RFC3339)
+				}
+
+				if v := attributes.Expires; v != nil {
+					state.ExpirationDate = time.Time(*v).Format(time.RFC3339)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleKeyResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client, err := metadata.Client.KeyVault.ManagedHSMKeysClient()
+			if err != nil {
+				return fmt.Errorf("building Managed HSM Keys client: %+v", err)
+			}
+
+			id, err := parse.ParseOptionallyVersionedNestedItemID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model KeyVaultManagedHardwareSecurityModuleKeyModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			parameters := keyvault.KeyUpdateParameters{
+				KeyOps: expandKeyVaultManagedHardwareSecurityModuleKeyOptions(model.KeyOpts),
+				KeyAttributes: &keyvault.KeyAttributes{
+					Enabled: utils.Bool(true),
+				},
+				Tags: tags.FromTypedObject(model.Tags),
+			}
+
+			if model.NotBeforeDate != "" {
+				notBeforeDate, _ := time.Parse(time.RFC3339, model.NotBeforeDate) // validated by schema
+				notBeforeUnixTime := date.UnixTime(notBeforeDate)
+				parameters.KeyAttributes.NotBefore = &notBeforeUnixTime
+			}
+
+			if model.ExpirationDate != "" {
+				expirationDate, _ := time.Parse(time.RFC3339, model.ExpirationDate) // validated by schema
+				expirationUnixTime := date.UnixTime(expirationDate)
+				parameters.KeyAttributes.Expires = &expirationUnixTime
+			}
+
+			if _, err := client.UpdateKey(ctx, id.KeyVaultBaseUrl, id.Name, "", parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleKeyResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client, err := metadata.Client.KeyVault.ManagedHSMKeysClient()
+			if err != nil {
+				return fmt.Errorf("building Managed HSM Keys client: %+v", err)
+			}
+
+			id, err := parse.ParseOptionallyVersionedNestedItemID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			shouldPurge := metadata.Client.Features.KeyVault.PurgeSoftDeletedKeysOnDestroy
+			description := fmt.Sprintf("Key %q (Managed HSM %q)", id.Name, id.KeyVaultBaseUrl)
+			deleter := deleteAndPurgeKey{
+				client:      client,
+				keyVaultUri: id.KeyVaultBaseUrl,
+				name:        id.Name,
+			}
+			if err := deleteAndOptionallyPurge(ctx, description, shouldPurge, deleter); err != nil {
+				return err
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func expandKeyVaultManagedHardwareSecurityModuleKeyOptions(input []string) *[]keyvault.JSONWebKeyOperation {
+	results := make([]keyvault.JSONWebKeyOperation, 0, len(input))
+	for _, option := range input {
+		results = append(results, keyvault.JSONWebKeyOperation(option))
+	}
+
+	return &results
+}
+
+func flattenKeyVaultManagedHardwareSecurityModuleKeyOptions(input *[]string) []string {
+	results := make([]string, 0)
+	if input != nil {
+		results = append(results, *input...)
+	}
+
+	return results
+}
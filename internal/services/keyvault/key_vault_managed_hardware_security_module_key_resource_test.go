@@ -0,0 +1,135 @@
+package keyvault_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KeyVaultManagedHardwareSecurityModuleKeyResource struct{}
+
+// NOTE: these tests are run as a part of TestAccKeyVaultManagedHardwareSecurityModule since only a single
+// Managed HSM can be provisioned at a time
+
+func testAccKeyVaultManagedHardwareSecurityModuleKey_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_key", "test")
+	r := KeyVaultManagedHardwareSecurityModuleKeyResource{}
+	roleAssignmentName := uuid.New().String()
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, roleAssignmentName),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("versioned_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccKeyVaultManagedHardwareSecurityModuleKey_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_key", "test")
+	r := KeyVaultManagedHardwareSecurityModuleKeyResource{}
+	roleAssignmentName := uuid.New().String()
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, roleAssignmentName),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data, roleAssignmentName),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleKeyResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ParseOptionallyVersionedNestedItemID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	keysClient, err := client.KeyVault.ManagedHSMKeysClient()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := keysClient.GetKey(ctx, id.KeyVaultBaseUrl, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(resp.Key != nil), nil
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleKeyResource) basic(data acceptance.TestData, roleAssignmentName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_managed_hardware_security_module_key" "test" {
+  name           = "acctest-key-%d"
+  vault_base_url = azurerm_key_vault_managed_hardware_security_module.test.hsm_uri
+  key_type       = "RSA-HSM"
+  key_size       = 2048
+  key_opts       = ["unwrapKey", "wrapKey"]
+
+  depends_on = [azurerm_key_vault_managed_hardware_security_module_role_assignment.crypto_user]
+}
+`, r.template(data, roleAssignmentName), data.RandomInteger)
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleKeyResource) complete(data acceptance.TestData, roleAssignmentName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_managed_hardware_security_module_key" "test" {
+  name            = "acctest-key-%d"
+  vault_base_url  = azurerm_key_vault_managed_hardware_security_module.test.hsm_uri
+  key_type        = "RSA-HSM"
+  key_size        = 2048
+  key_opts        = ["decrypt", "encrypt", "unwrapKey", "wrapKey"]
+  not_before_date = "2021-01-01T01:02:03Z"
+  expiration_date = "2034-01-01T01:02:03Z"
+
+  tags = {
+    Env = "Test"
+  }
+
+  depends_on = [azurerm_key_vault_managed_hardware_security_module_role_assignment.crypto_user]
+}
+`, r.template(data, roleAssignmentName), data.RandomInteger)
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleKeyResource) template(data acceptance.TestData, roleAssignmentName string) string {
+	// 21dbd100-6940-42c2-9190-5d6cb909625b is the built-in Managed HSM Crypto User role
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_managed_hardware_security_module_role_assignment" "crypto_user" {
+  name               = "%s"
+  vault_base_url     = azurerm_key_vault_managed_hardware_security_module.test.hsm_uri
+  scope              = "/keys"
+  role_definition_id = "Microsoft.KeyVault/providers/Microsoft.Authorization/roleDefinitions/21dbd100-6940-42c2-9190-5d6cb909625b"
+  principal_id       = data.azurerm_client_config.current.object_id
+}
+`, KeyVaultManagedHardwareSecurityModuleResource{}.download(data), roleAssignmentName)
+}
@@ -1,8 +1,15 @@
 package keyvault
 
 import (
+	"context"
+	"crypto/rsa"
+	"crypto/sha1" // nolint: gosec
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
 	"fmt"
 	"log"
+	"math/big"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/keyvault/mgmt/2021-10-01/keyvault" // nolint: staticcheck
@@ -13,7 +20,9 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/client"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	keyvaultdataplane "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/sdk/v7.4/keyvault"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -26,6 +35,7 @@ func resourceKeyVaultManagedHardwareSecurityModule() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceArmKeyVaultManagedHardwareSecurityModuleCreate,
 		Read:   resourceArmKeyVaultManagedHardwareSecurityModuleRead,
+		Update: resourceArmKeyVaultManagedHardwareSecurityModuleUpdate,
 		Delete: resourceArmKeyVaultManagedHardwareSecurityModuleDelete,
 
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
@@ -36,9 +46,23 @@ func resourceKeyVaultManagedHardwareSecurityModule() *pluginsdk.Resource {
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
 			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+			if !d.NewValueKnown("security_domain_key_vault_certificate_ids") || !d.NewValueKnown("security_domain_quorum") {
+				return nil
+			}
+
+			certificateIds := d.Get("security_domain_key_vault_certificate_ids").([]interface{})
+			if quorum := d.Get("security_domain_quorum").(int); len(certificateIds) > 0 && quorum > len(certificateIds) {
+				return fmt.Errorf("`security_domain_quorum` (%d) must be less than or equal to the number of `security_domain_key_vault_certificate_ids` (%d)", quorum, len(certificateIds))
+			}
+
+			return nil
+		}),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -131,8 +155,33 @@ func resourceKeyVaultManagedHardwareSecurityModule() *pluginsdk.Resource {
 				},
 			},
 
+			"security_domain_key_vault_certificate_ids": {
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				MinItems:     3,
+				MaxItems:     10,
+				RequiredWith: []string{"security_domain_quorum"},
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validate.NestedItemId,
+				},
+			},
+
+			"security_domain_quorum": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"security_domain_key_vault_certificate_ids"},
+				ValidateFunc: validation.IntBetween(2, 10),
+			},
+
 			// https://github.com/Azure/azure-rest-api-specs/issues/13365
 			"tags": tags.ForceNewSchema(),
+
+			"security_domain_encrypted_data": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
 		},
 	}
 }
@@ -191,6 +240,62 @@ func resourceArmKeyVaultManagedHardwareSecurityModuleCreate(d *pluginsdk.Resourc
 	}
 
 	d.SetId(id.ID())
+
+	// activating the Managed HSM requires downloading the Security Domain
+	if certificateIds := d.Get("security_domain_key_vault_certificate_ids").([]interface{}); len(certificateIds) > 0 {
+		resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			return fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+		if resp.Properties == nil || resp.Properties.HsmURI == nil {
+			return fmt.Errorf("retrieving %s: `properties.hsmUri` was nil", id)
+		}
+
+		encryptedData, err := securityDomainDownload(ctx, meta.(*clients.Client).KeyVault, *resp.Properties.HsmURI, certificateIds, d.Get("security_domain_quorum").(int))
+		if err != nil {
+			return fmt.Errorf("downloading the Security Domain for %s: %+v", id, err)
+		}
+		d.Set("security_domain_encrypted_data", encryptedData)
+	}
+
+	return resourceArmKeyVaultManagedHardwareSecurityModuleRead(d, meta)
+}
+
+func resourceArmKeyVaultManagedHardwareSecurityModuleUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).KeyVault.ManagedHsmClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ManagedHSMID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChanges("security_domain_key_vault_certificate_ids", "security_domain_quorum") {
+		certificateIds := d.Get("security_domain_key_vault_certificate_ids").([]interface{})
+		if len(certificateIds) > 0 {
+			resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if resp.Properties == nil || resp.Properties.HsmURI == nil {
+				return fmt.Errorf("retrieving %s: `properties.hsmUri` was nil", id)
+			}
+
+			// the Security Domain can only be downloaded once, after which the Managed HSM is activated - which
+			// includes Managed HSMs which have been imported or activated outside of Terraform
+			if resp.Properties.ProvisioningState == keyvault.ProvisioningStateActivated || d.Get("security_domain_encrypted_data").(string) != "" {
+				return fmt.Errorf("the Security Domain for %s has already been downloaded and cannot be changed", id)
+			}
+
+			encryptedData, err := securityDomainDownload(ctx, meta.(*clients.Client).KeyVault, *resp.Properties.HsmURI, certificateIds, d.Get("security_domain_quorum").(int))
+			if err != nil {
+				return fmt.Errorf("downloading the Security Domain for %s: %+v", id, err)
+			}
+			d.Set("security_domain_encrypted_data", encryptedData)
+		}
+	}
+
 	return resourceArmKeyVaultManagedHardwareSecurityModuleRead(d, meta)
 }
 
@@ -303,6 +408,108 @@ func resourceArmKeyVaultManagedHardwareSecurityModuleDelete(d *pluginsdk.Resourc
 	return nil
 }
 
+func securityDomainDownload(ctx context.Context, keyVaultsClient *client.Client, hsmUri string, certificateIds []interface{}, quorum int) (string, error) {
+	securityDomainClient, err := keyVaultsClient.ManagedHSMSecurityDomainClient()
+	if err != nil {
+		return "", err
+	}
+
+	certificates := make([]keyvaultdataplane.SecurityDomainJSONWebKey, 0)
+	for _, certificateId := range certificateIds {
+		certificateId, err := parse.ParseNestedItemID(certificateId.(string))
+		if err != nil {
+			return "", err
+		}
+
+		certificate, err := keyVaultsClient.ManagementClient.GetCertificate(ctx, certificateId.KeyVaultBaseUrl, certificateId.Name, certificateId.Version)
+		if err != nil {
+			return "", fmt.Errorf("retrieving %s: %+v", certificateId, err)
+		}
+		if certificate.Cer == nil {
+			return "", fmt.Errorf("retrieving %s: `cer` was nil", certificateId)
+		}
+
+		key, err := securityDomainJSONWebKeyFromCertificate(certificateId.ID(), *certificate.Cer)
+		if err != nil {
+			return "", fmt.Errorf("building the JSON Web Key for %s: %+v", certificateId, err)
+		}
+		certificates = append(certificates, *key)
+	}
+
+	parameters := keyvaultdataplane.CertificateInfoObject{
+		Certificates: &certificates,
+		Required:     utils.Int32(int32(quorum)),
+	}
+	result, err := securityDomainClient.Download(ctx, hsmUri, parameters)
+	if err != nil {
+		return "", err
+	}
+	if result.Value == nil {
+		return "", fmt.Errorf("`value` was nil")
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return "", fmt.Errorf("internal-error: context had no deadline")
+	}
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{string(keyvaultdataplane.OperationStatusInProgress)},
+		Target:     []string{string(keyvaultdataplane.OperationStatusSuccess)},
+		Refresh:    securityDomainDownloadRefreshFunc(ctx, securityDomainClient, hsmUri),
+		MinTimeout: 15 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return "", fmt.Errorf("waiting for the Security Domain download to complete: %+v", err)
+	}
+
+	return *result.Value, nil
+}
+
+func securityDomainDownloadRefreshFunc(ctx context.Context, client *keyvaultdataplane.HSMSecurityDomainClient, hsmUri string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.DownloadPending(ctx, hsmUri)
+		if err != nil {
+			return resp, "Error", err
+		}
+
+		if resp.Status == keyvaultdataplane.OperationStatusFailed {
+			return resp, string(resp.Status), fmt.Errorf("downloading the Security Domain failed: %s", utils.NormalizeNilableString(resp.StatusDetails))
+		}
+
+		return resp, string(resp.Status), nil
+	}
+}
+
+// securityDomainJSONWebKeyFromCertificate builds the public key in JWK format which the Security Domain is encrypted with
+func securityDomainJSONWebKeyFromCertificate(kid string, cer []byte) (*keyvaultdataplane.SecurityDomainJSONWebKey, error) {
+	certificate, err := x509.ParseCertificate(cer)
+	if err != nil {
+		return nil, fmt.Errorf("parsing certificate: %+v", err)
+	}
+
+	publicKey, ok := certificate.PublicKey.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("expected an RSA public key but got %T", certificate.PublicKey)
+	}
+
+	sha1Thumbprint := sha1.Sum(cer) // nolint: gosec
+	sha256Thumbprint := sha256.Sum256(cer)
+
+	return &keyvaultdataplane.SecurityDomainJSONWebKey{
+		Kid:     utils.String(kid),
+		Kty:     utils.String("RSA"),
+		KeyOps:  &[]string{"verify"},
+		N:       utils.String(base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes())),
+		E:       utils.String(base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes())),
+		X5c:     &[]string{base64.StdEncoding.EncodeToString(cer)},
+		Use:     utils.String("enc"),
+		X5t:     utils.String(base64.RawURLEncoding.EncodeToString(sha1Thumbprint[:])),
+		X5tS256: utils.String(base64.RawURLEncoding.EncodeToString(sha256Thumbprint[:])),
+		Alg:     utils.String("RSA-OAEP-256"),
+	}, nil
+}
+
 func expandMHSMNetworkAcls(input []interface{}) *keyvault.MHSMNetworkRuleSet {
 	if len(input) == 0 {
 		return nil
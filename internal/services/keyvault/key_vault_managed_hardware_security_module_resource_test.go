@@ -26,6 +26,18 @@ func TestAccKeyVaultManagedHardwareSecurityModule(t *testing.T) {
 			"basic":    testAccKeyVaultManagedHardwareSecurityModule_basic,
 			"update":   testAccKeyVaultManagedHardwareSecurityModule_requiresImport,
 			"complete": testAccKeyVaultManagedHardwareSecurityModule_complete,
+			"download": testAccKeyVaultManagedHardwareSecurityModule_download,
+		},
+		"roleDefinition": {
+			"basic":  testAccKeyVaultManagedHardwareSecurityModuleRoleDefinition_basic,
+			"update": testAccKeyVaultManagedHardwareSecurityModuleRoleDefinition_update,
+		},
+		"roleAssignment": {
+			"basic": testAccKeyVaultManagedHardwareSecurityModuleRoleAssignment_basic,
+		},
+		"key": {
+			"basic":    testAccKeyVaultManagedHardwareSecurityModuleKey_basic,
+			"complete": testAccKeyVaultManagedHardwareSecurityModuleKey_complete,
 		},
 	})
 }
@@ -75,6 +87,22 @@ func testAccKeyVaultManagedHardwareSecurityModule_complete(t *testing.T) {
 	})
 }
 
+func testAccKeyVaultManagedHardwareSecurityModule_download(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module", "test")
+	r := KeyVaultManagedHardwareSecurityModuleResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.download(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("security_domain_encrypted_data").Exists(),
+			),
+		},
+		data.ImportStep("security_domain_encrypted_data", "security_domain_key_vault_certificate_ids", "security_domain_quorum"),
+	})
+}
+
 func (KeyVaultManagedHardwareSecurityModuleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.ManagedHSMID(state.ID)
 	if err != nil {
@@ -174,6 +202,105 @@ resource "azurerm_key_vault_managed_hardware_security_module" "test" {
 `, template, data.RandomInteger)
 }
 
+func (r KeyVaultManagedHardwareSecurityModuleResource) download(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_key_vault_managed_hardware_security_module" "test" {
+  name                     = "kvHsm%d"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  sku_name                 = "Standard_B1"
+  tenant_id                = data.azurerm_client_config.current.tenant_id
+  admin_object_ids         = [data.azurerm_client_config.current.object_id]
+  purge_protection_enabled = false
+
+  security_domain_key_vault_certificate_ids = [for cert in azurerm_key_vault_certificate.cert : cert.id]
+  security_domain_quorum                    = 2
+}
+`, r.securityDomainTemplate(data), data.RandomInteger)
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleResource) securityDomainTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault" "test" {
+  name                       = "acc%d"
+  location                   = azurerm_resource_group.test.location
+  resource_group_name        = azurerm_resource_group.test.name
+  tenant_id                  = data.azurerm_client_config.current.tenant_id
+  sku_name                   = "standard"
+  soft_delete_retention_days = 7
+
+  access_policy {
+    tenant_id = data.azurerm_client_config.current.tenant_id
+    object_id = data.azurerm_client_config.current.object_id
+
+    certificate_permissions = [
+      "Create",
+      "Delete",
+      "DeleteIssuers",
+      "Get",
+      "Purge",
+      "Update"
+    ]
+  }
+}
+
+resource "azurerm_key_vault_certificate" "cert" {
+  count        = 3
+  name         = "acchsmcert${count.index}"
+  key_vault_id = azurerm_key_vault.test.id
+
+  certificate_policy {
+    issuer_parameters {
+      name = "Self"
+    }
+
+    key_properties {
+      exportable = true
+      key_size   = 2048
+      key_type   = "RSA"
+      reuse_key  = true
+    }
+
+    lifetime_action {
+      action {
+        action_type = "AutoRenew"
+      }
+
+      trigger {
+        days_before_expiry = 30
+      }
+    }
+
+    secret_properties {
+      content_type = "application/x-pkcs12"
+    }
+
+    x509_certificate_properties {
+      extended_key_usage = []
+      key_usage = [
+        "cRLSign",
+        "dataEncipherment",
+        "digitalSignature",
+        "keyAgreement",
+        "keyCertSign",
+        "keyEncipherment",
+      ]
+      subject            = "CN=hello-world"
+      validity_in_months = 12
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (KeyVaultManagedHardwareSecurityModuleResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 data "azurerm_client_config" "current" {
@@ -0,0 +1,198 @@
+package keyvault
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	keyvaultdataplane "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/sdk/v7.4/keyvault"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KeyVaultManagedHardwareSecurityModuleRoleAssignmentModel struct {
+	Name             string `tfschema:"name"`
+	VaultBaseUrl     string `tfschema:"vault_base_url"`
+	Scope            string `tfschema:"scope"`
+	RoleDefinitionId string `tfschema:"role_definition_id"`
+	PrincipalId      string `tfschema:"principal_id"`
+	ResourceId       string `tfschema:"resource_id"`
+}
+
+type KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource struct{}
+
+var _ sdk.Resource = KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource{}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"vault_base_url": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateFunc:     validation.IsURLWithHTTPS,
+			DiffSuppressFunc: suppress.DiffSuppressManagedHSMBaseUrl,
+		},
+
+		"scope": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^/(keys(/[^/]+)?)?$`),
+				"`scope` must be either `/`, `/keys` or `/keys/{key-name}`",
+			),
+		},
+
+		"role_definition_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"principal_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+	}
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"resource_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource) ResourceType() string {
+	return "azurerm_key_vault_managed_hardware_security_module_role_assignment"
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource) ModelObject() interface{} {
+	return &KeyVaultManagedHardwareSecurityModuleRoleAssignmentModel{}
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ManagedHSMRoleAssignmentID
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client, err := metadata.Client.KeyVault.ManagedHSMRoleAssignmentsClient()
+			if err != nil {
+				return fmt.Errorf("building Managed HSM Role Assignments client: %+v", err)
+			}
+
+			var model KeyVaultManagedHardwareSecurityModuleRoleAssignmentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := parse.NewManagedHSMRoleAssignmentID(model.VaultBaseUrl, model.Scope, model.Name)
+			existing, err := client.Get(ctx, id.VaultBaseUrl, id.Scope, id.Name)
+			if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := keyvaultdataplane.RoleAssignmentCreateParameters{
+				Properties: &keyvaultdataplane.RoleAssignmentProperties{
+					RoleDefinitionID: utils.String(model.RoleDefinitionId),
+					PrincipalID:      utils.String(model.PrincipalId),
+				},
+			}
+			if _, err := client.Create(ctx, id.VaultBaseUrl, id.Scope, id.Name, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client, err := metadata.Client.KeyVault.ManagedHSMRoleAssignmentsClient()
+			if err != nil {
+				return fmt.Errorf("building Managed HSM Role Assignments client: %+v", err)
+			}
+
+			id, err := parse.ManagedHSMRoleAssignmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.VaultBaseUrl, id.Scope, id.Name)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := KeyVaultManagedHardwareSecurityModuleRoleAssignmentModel{
+				Name:         id.Name,
+				VaultBaseUrl: id.VaultBaseUrl,
+				Scope:        id.Scope,
+				ResourceId:   utils.NormalizeNilableString(resp.ID),
+			}
+
+			if props := resp.Properties; props != nil {
+				state.RoleDefinitionId = utils.NormalizeNilableString(props.RoleDefinitionID)
+				state.PrincipalId = utils.NormalizeNilableString(props.PrincipalID)
+			}
+
+			return metadata.Encode(&state)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client, err := metadata.Client.KeyVault.ManagedHSMRoleAssignmentsClient()
+			if err != nil {
+				return fmt.Errorf("building Managed HSM Role Assignments client: %+v", err)
+			}
+
+			id, err := parse.ManagedHSMRoleAssignmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if resp, err := client.Delete(ctx, id.VaultBaseUrl, id.Scope, id.Name); err != nil {
+				if !utils.ResponseWasNotFound(resp.Response) {
+					return fmt.Errorf("deleting %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
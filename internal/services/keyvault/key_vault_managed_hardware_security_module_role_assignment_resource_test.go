@@ -0,0 +1,72 @@
+package keyvault_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource struct{}
+
+// NOTE: these tests are run as a part of TestAccKeyVaultManagedHardwareSecurityModule since only a single
+// Managed HSM can be provisioned at a time
+
+func testAccKeyVaultManagedHardwareSecurityModuleRoleAssignment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module_role_assignment", "test")
+	r := KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, uuid.New().String(), uuid.New().String()),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("resource_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ManagedHSMRoleAssignmentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	roleAssignmentsClient, err := client.KeyVault.ManagedHSMRoleAssignmentsClient()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := roleAssignmentsClient.Get(ctx, id.VaultBaseUrl, id.Scope, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(resp.Properties != nil), nil
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource) basic(data acceptance.TestData, roleDefinitionName, name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_key_vault_managed_hardware_security_module_role_assignment" "test" {
+  name               = "%s"
+  vault_base_url     = azurerm_key_vault_managed_hardware_security_module.test.hsm_uri
+  scope              = "/keys"
+  role_definition_id = azurerm_key_vault_managed_hardware_security_module_role_definition.test.resource_manager_id
+  principal_id       = data.azurerm_client_config.current.object_id
+}
+`, KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource{}.basic(data, roleDefinitionName), name)
+}
@@ -0,0 +1,332 @@
+package keyvault
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/parse"
+	keyvaultdataplane "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/sdk/v7.4/keyvault"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type KeyVaultManagedHardwareSecurityModuleRoleDefinitionModel struct {
+	Name              string                                                 `tfschema:"name"`
+	VaultBaseUrl      string                                                 `tfschema:"vault_base_url"`
+	RoleName          string                                                 `tfschema:"role_name"`
+	Description       string                                                 `tfschema:"description"`
+	Permission        []KeyVaultManagedHardwareSecurityModulePermissionModel `tfschema:"permission"`
+	ResourceManagerId string                                                 `tfschema:"resource_manager_id"`
+}
+
+type KeyVaultManagedHardwareSecurityModulePermissionModel struct {
+	Actions        []string `tfschema:"actions"`
+	NotActions     []string `tfschema:"not_actions"`
+	DataActions    []string `tfschema:"data_actions"`
+	NotDataActions []string `tfschema:"not_data_actions"`
+}
+
+type KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource struct{}
+
+var _ sdk.ResourceWithUpdate = KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource{}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+
+		"vault_base_url": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			ForceNew:         true,
+			ValidateFunc:     validation.IsURLWithHTTPS,
+			DiffSuppressFunc: suppress.DiffSuppressManagedHSMBaseUrl,
+		},
+
+		"role_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"permission": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"actions": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"not_actions": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"data_actions": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"not_data_actions": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"resource_manager_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource) ResourceType() string {
+	return "azurerm_key_vault_managed_hardware_security_module_role_definition"
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource) ModelObject() interface{} {
+	return &KeyVaultManagedHardwareSecurityModuleRoleDefinitionModel{}
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ManagedHSMRoleDefinitionID
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client, err := metadata.Client.KeyVault.ManagedHSMRoleDefinitionsClient()
+			if err != nil {
+				return fmt.Errorf("building Managed HSM Role Definitions client: %+v", err)
+			}
+
+			var model KeyVaultManagedHardwareSecurityModuleRoleDefinitionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// custom role definitions can only be created at the global scope
+			id := parse.NewManagedHSMRoleDefinitionID(model.VaultBaseUrl, string(keyvaultdataplane.RoleScopeGlobal), model.Name)
+			existing, err := client.Get(ctx, id.VaultBaseUrl, id.Scope, id.Name)
+			if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := keyvaultdataplane.RoleDefinitionCreateParameters{
+				Properties: &keyvaultdataplane.RoleDefinitionProperties{
+					RoleName:         utils.String(model.RoleName),
+					Description:      utils.String(model.Description),
+					RoleType:         keyvaultdataplane.RoleTypeCustomRole,
+					Permissions:      expandKeyVaultManagedHardwareSecurityModulePermissionModel(model.Permission),
+					AssignableScopes: &[]keyvaultdataplane.RoleScope{keyvaultdataplane.RoleScopeGlobal},
+				},
+			}
+			if _, err := client.CreateOrUpdate(ctx, id.VaultBaseUrl, id.Scope, id.Name, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client, err := metadata.Client.KeyVault.ManagedHSMRoleDefinitionsClient()
+			if err != nil {
+				return fmt.Errorf("building Managed HSM Role Definitions client: %+v", err)
+			}
+
+			id, err := parse.ManagedHSMRoleDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.VaultBaseUrl, id.Scope, id.Name)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := KeyVaultManagedHardwareSecurityModuleRoleDefinitionModel{
+				Name:              id.Name,
+				VaultBaseUrl:      id.VaultBaseUrl,
+				ResourceManagerId: utils.NormalizeNilableString(resp.ID),
+			}
+
+			if props := resp.Properties; props != nil {
+				state.RoleName = utils.NormalizeNilableString(props.RoleName)
+				state.Description = utils.NormalizeNilableString(props.Description)
+				state.Permission = flattenKeyVaultManagedHardwareSecurityModulePermissionModel(props.Permissions)
+			}
+
+			return metadata.Encode(&state)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client, err := metadata.Client.KeyVault.ManagedHSMRoleDefinitionsClient()
+			if err != nil {
+				return fmt.Errorf("building Managed HSM Role Definitions client: %+v", err)
+			}
+
+			id, err := parse.ManagedHSMRoleDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model KeyVaultManagedHardwareSecurityModuleRoleDefinitionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, id.VaultBaseUrl, id.Scope, id.Name)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+			}
+
+			properties := existing.Properties
+			if metadata.ResourceData.HasChange("role_name") {
+				properties.RoleName = utils.String(model.RoleName)
+			}
+
+			if metadata.ResourceData.HasChange("description") {
+				properties.Description = utils.String(model.Description)
+			}
+
+			if metadata.ResourceData.HasChange("permission") {
+				properties.Permissions = expandKeyVaultManagedHardwareSecurityModulePermissionModel(model.Permission)
+			}
+
+			parameters := keyvaultdataplane.RoleDefinitionCreateParameters{
+				Properties: properties,
+			}
+			if _, err := client.CreateOrUpdate(ctx, id.VaultBaseUrl, id.Scope, id.Name, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client, err := metadata.Client.KeyVault.ManagedHSMRoleDefinitionsClient()
+			if err != nil {
+				return fmt.Errorf("building Managed HSM Role Definitions client: %+v", err)
+			}
+
+			id, err := parse.ManagedHSMRoleDefinitionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if resp, err := client.Delete(ctx, id.VaultBaseUrl, id.Scope, id.Name); err != nil {
+				if !utils.ResponseWasNotFound(resp.Response) {
+					return fmt.Errorf("deleting %s: %+v", *id, err)
+				}
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func expandKeyVaultManagedHardwareSecurityModulePermissionModel(input []KeyVaultManagedHardwareSecurityModulePermissionModel) *[]keyvaultdataplane.Permission {
+	permissions := make([]keyvaultdataplane.Permission, 0)
+	for _, item := range input {
+		permissions = append(permissions, keyvaultdataplane.Permission{
+			Actions:        utils.StringSlice(item.Actions),
+			NotActions:     utils.StringSlice(item.NotActions),
+			DataActions:    utils.StringSlice(item.DataActions),
+			NotDataActions: utils.StringSlice(item.NotDataActions),
+		})
+	}
+
+	return &permissions
+}
+
+func flattenKeyVaultManagedHardwareSecurityModulePermissionModel(input *[]keyvaultdataplane.Permission) []KeyVaultManagedHardwareSecurityModulePermissionModel {
+	outputs := make([]KeyVaultManagedHardwareSecurityModulePermissionModel, 0)
+	if input == nil {
+		return outputs
+	}
+
+	for _, item := range *input {
+		output := KeyVaultManagedHardwareSecurityModulePermissionModel{}
+		if item.Actions != nil {
+			output.Actions = *item.Actions
+		}
+		if item.NotActions != nil {
+			output.NotActions = *item.NotActions
+		}
+		if item.DataActions != nil {
+			output.DataActions = *item.DataActions
+		}
+		if item.NotDataActions != nil {
+			output.NotDataActions = *item.NotDataActions
+		}
+		outputs = append(outputs, output)
+	}
+
+	return outputs
+}
@@ -0,0 +1,129 @@
+package parse
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+const (
+	managedHSMRoleAssignmentsSegment = "/providers/Microsoft.Authorization/roleAssignments/"
+	managedHSMRoleDefinitionsSegment = "/providers/Microsoft.Authorization/roleDefinitions/"
+)
+
+var _ resourceids.Id = ManagedHSMRoleAssignmentId{}
+
+type ManagedHSMRoleAssignmentId struct {
+	VaultBaseUrl string
+	Scope        string
+	Name         string
+}
+
+func NewManagedHSMRoleAssignmentID(vaultBaseUrl, scope, name string) ManagedHSMRoleAssignmentId {
+	return ManagedHSMRoleAssignmentId{
+		VaultBaseUrl: strings.TrimSuffix(vaultBaseUrl, "/"),
+		Scope:        scope,
+		Name:         name,
+	}
+}
+
+func (id ManagedHSMRoleAssignmentId) ID() string {
+	// example: https://my-hsm.managedhsm.azure.net/keys/providers/Microsoft.Authorization/roleAssignments/00000000-0000-0000-0000-000000000000
+	return id.VaultBaseUrl + strings.TrimSuffix(id.Scope, "/") + managedHSMRoleAssignmentsSegment + id.Name
+}
+
+func (id ManagedHSMRoleAssignmentId) String() string {
+	components := []string{
+		fmt.Sprintf("Base Url %q", id.VaultBaseUrl),
+		fmt.Sprintf("Scope %q", id.Scope),
+		fmt.Sprintf("Name %q", id.Name),
+	}
+	return fmt.Sprintf("Managed HSM Role Assignment %s", strings.Join(components, " / "))
+}
+
+// ManagedHSMRoleAssignmentID parses a Managed HSM Role Assignment ID into a ManagedHSMRoleAssignmentId object
+func ManagedHSMRoleAssignmentID(input string) (*ManagedHSMRoleAssignmentId, error) {
+	vaultBaseUrl, scope, name, err := parseManagedHSMRoleId(input, managedHSMRoleAssignmentsSegment)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ManagedHSMRoleAssignmentId{
+		VaultBaseUrl: vaultBaseUrl,
+		Scope:        scope,
+		Name:         name,
+	}, nil
+}
+
+var _ resourceids.Id = ManagedHSMRoleDefinitionId{}
+
+type ManagedHSMRoleDefinitionId struct {
+	VaultBaseUrl string
+	Scope        string
+	Name         string
+}
+
+func NewManagedHSMRoleDefinitionID(vaultBaseUrl, scope, name string) ManagedHSMRoleDefinitionId {
+	return ManagedHSMRoleDefinitionId{
+		VaultBaseUrl: strings.TrimSuffix(vaultBaseUrl, "/"),
+		Scope:        scope,
+		Name:         name,
+	}
+}
+
+func (id ManagedHSMRoleDefinitionId) ID() string {
+	// example: https://my-hsm.managedhsm.azure.net/providers/Microsoft.Authorization/roleDefinitions/00000000-0000-0000-0000-000000000000
+	return id.VaultBaseUrl + strings.TrimSuffix(id.Scope, "/") + managedHSMRoleDefinitionsSegment + id.Name
+}
+
+func (id ManagedHSMRoleDefinitionId) String() string {
+	components := []string{
+		fmt.Sprintf("Base Url %q", id.VaultBaseUrl),
+		fmt.Sprintf("Scope %q", id.Scope),
+		fmt.Sprintf("Name %q", id.Name),
+	}
+	return fmt.Sprintf("Managed HSM Role Definition %s", strings.Join(components, " / "))
+}
+
+// ManagedHSMRoleDefinitionID parses a Managed HSM Role Definition ID into a ManagedHSMRoleDefinitionId object
+func ManagedHSMRoleDefinitionID(input string) (*ManagedHSMRoleDefinitionId, error) {
+	vaultBaseUrl, scope, name, err := parseManagedHSMRoleId(input, managedHSMRoleDefinitionsSegment)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ManagedHSMRoleDefinitionId{
+		VaultBaseUrl: vaultBaseUrl,
+		Scope:        scope,
+		Name:         name,
+	}, nil
+}
+
+func parseManagedHSMRoleId(input, segment string) (vaultBaseUrl, scope, name string, err error) {
+	idURL, err := url.ParseRequestURI(input)
+	if err != nil {
+		return "", "", "", fmt.Errorf("parsing %q: %+v", input, err)
+	}
+	if idURL.Scheme == "" || idURL.Host == "" {
+		return "", "", "", fmt.Errorf("expected %q to contain a scheme and host", input)
+	}
+
+	components := strings.Split(idURL.Path, segment)
+	if len(components) != 2 {
+		return "", "", "", fmt.Errorf("expected %q to contain %q once", input, segment)
+	}
+
+	name = components[1]
+	if name == "" || strings.Contains(name, "/") {
+		return "", "", "", fmt.Errorf("expected %q to end with a name after %q", input, segment)
+	}
+
+	scope = components[0]
+	if scope == "" {
+		scope = "/"
+	}
+
+	return fmt.Sprintf("%s://%s", idURL.Scheme, idURL.Host), scope, name, nil
+}
@@ -65,5 +65,8 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		KeyVaultCertificateContactsResource{},
+		KeyVaultManagedHardwareSecurityModuleKeyResource{},
+		KeyVaultManagedHardwareSecurityModuleRoleAssignmentResource{},
+		KeyVaultManagedHardwareSecurityModuleRoleDefinitionResource{},
 	}
 }
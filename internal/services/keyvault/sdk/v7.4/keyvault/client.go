@@ -0,0 +1,270 @@
+// Package keyvault implements a subset of the Azure Key Vault data plane API version 7.4.
+//
+// The vendored v7.1 SDK doesn't expose the Key Rotation Policy and Rotate Key operations, nor the Managed HSM
+// Security Domain and Role Based Access Control operations, so these are implemented here until the SDK is upgraded.
+package keyvault
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/tracing"
+)
+
+const (
+	apiVersion = "7.4"
+	fqdn       = "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/sdk/v7.4/keyvault"
+)
+
+// BaseClient is the base client for Keyvault.
+type BaseClient struct {
+	autorest.Client
+}
+
+// New creates an instance of the BaseClient client.
+func New() BaseClient {
+	return BaseClient{
+		Client: autorest.NewClientWithUserAgent(UserAgent()),
+	}
+}
+
+// GetKeyRotationPolicy lists the policy for a key. This operation requires the keys/get permission.
+// Parameters:
+// vaultBaseURL - the vault name, for example https://myvault.vault.azure.net.
+// keyName - the name of the key in a given key vault.
+func (client BaseClient) GetKeyRotationPolicy(ctx context.Context, vaultBaseURL string, keyName string) (result KeyRotationPolicy, err error) {
+	if tracing.IsEnabled() {
+		ctx = tracing.StartSpan(ctx, fqdn+"/BaseClient.GetKeyRotationPolicy")
+		defer func() {
+			sc := -1
+			if result.Response.Response != nil {
+				sc = result.Response.Response.StatusCode
+			}
+			tracing.EndSpan(ctx, sc, err)
+		}()
+	}
+	req, err := client.GetKeyRotationPolicyPreparer(ctx, vaultBaseURL, keyName)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyvault.BaseClient", "GetKeyRotationPolicy", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.GetKeyRotationPolicySender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "keyvault.BaseClient", "GetKeyRotationPolicy", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.GetKeyRotationPolicyResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyvault.BaseClient", "GetKeyRotationPolicy", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// GetKeyRotationPolicyPreparer prepares the GetKeyRotationPolicy request.
+func (client BaseClient) GetKeyRotationPolicyPreparer(ctx context.Context, vaultBaseURL string, keyName string) (*http.Request, error) {
+	urlParameters := map[string]interface{}{
+		"vaultBaseUrl": vaultBaseURL,
+	}
+
+	pathParameters := map[string]interface{}{
+		"key-name": autorest.Encode("path", keyName),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": apiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithCustomBaseURL("{vaultBaseUrl}", urlParameters),
+		autorest.WithPathParameters("/keys/{key-name}/rotationpolicy", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// GetKeyRotationPolicySender sends the GetKeyRotationPolicy request. The method will close the
+// http.Response Body if it receives an error.
+func (client BaseClient) GetKeyRotationPolicySender(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+// GetKeyRotationPolicyResponder handles the response to the GetKeyRotationPolicy request. The method always
+// closes the http.Response Body.
+func (client BaseClient) GetKeyRotationPolicyResponder(resp *http.Response) (result KeyRotationPolicy, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// RotateKey creates a new key version, stores it, then returns key parameters, attributes and policy to the
+// client. This operation requires the keys/rotate permission.
+// Parameters:
+// vaultBaseURL - the vault name, for example https://myvault.vault.azure.net.
+// keyName - the name of key to be rotated. The system will generate a new version in the specified key.
+func (client BaseClient) RotateKey(ctx context.Context, vaultBaseURL string, keyName string) (result KeyBundle, err error) {
+	if tracing.IsEnabled() {
+		ctx = tracing.StartSpan(ctx, fqdn+"/BaseClient.RotateKey")
+		defer func() {
+			sc := -1
+			if result.Response.Response != nil {
+				sc = result.Response.Response.StatusCode
+			}
+			tracing.EndSpan(ctx, sc, err)
+		}()
+	}
+	req, err := client.RotateKeyPreparer(ctx, vaultBaseURL, keyName)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyvault.BaseClient", "RotateKey", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.RotateKeySender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "keyvault.BaseClient", "RotateKey", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.RotateKeyResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyvault.BaseClient", "RotateKey", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// RotateKeyPreparer prepares the RotateKey request.
+func (client BaseClient) RotateKeyPreparer(ctx context.Context, vaultBaseURL string, keyName string) (*http.Request, error) {
+	urlParameters := map[string]interface{}{
+		"vaultBaseUrl": vaultBaseURL,
+	}
+
+	pathParameters := map[string]interface{}{
+		"key-name": autorest.Encode("path", keyName),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": apiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsPost(),
+		autorest.WithCustomBaseURL("{vaultBaseUrl}", urlParameters),
+		autorest.WithPathParameters("/keys/{key-name}/rotate", pathParameters),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// RotateKeySender sends the RotateKey request. The method will close the
+// http.Response Body if it receives an error.
+func (client BaseClient) RotateKeySender(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+// RotateKeyResponder handles the response to the RotateKey request. The method always
+// closes the http.Response Body.
+func (client BaseClient) RotateKeyResponder(resp *http.Response) (result KeyBundle, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
+
+// UpdateKeyRotationPolicy set specified members in the key policy. Leave others as undefined. This operation
+// requires the keys/update permission.
+// Parameters:
+// vaultBaseURL - the vault name, for example https://myvault.vault.azure.net.
+// keyName - the name of the key in the given vault.
+// keyRotationPolicy - the policy for the key.
+func (client BaseClient) UpdateKeyRotationPolicy(ctx context.Context, vaultBaseURL string, keyName string, keyRotationPolicy KeyRotationPolicy) (result KeyRotationPolicy, err error) {
+	if tracing.IsEnabled() {
+		ctx = tracing.StartSpan(ctx, fqdn+"/BaseClient.UpdateKeyRotationPolicy")
+		defer func() {
+			sc := -1
+			if result.Response.Response != nil {
+				sc = result.Response.Response.StatusCode
+			}
+			tracing.EndSpan(ctx, sc, err)
+		}()
+	}
+	req, err := client.UpdateKeyRotationPolicyPreparer(ctx, vaultBaseURL, keyName, keyRotationPolicy)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyvault.BaseClient", "UpdateKeyRotationPolicy", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.UpdateKeyRotationPolicySender(req)
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "keyvault.BaseClient", "UpdateKeyRotationPolicy", resp, "Failure sending request")
+		return
+	}
+
+	result, err = client.UpdateKeyRotationPolicyResponder(resp)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "keyvault.BaseClient", "UpdateKeyRotationPolicy", resp, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+// UpdateKeyRotationPolicyPreparer prepares the UpdateKeyRotationPolicy request.
+func (client BaseClient) UpdateKeyRotationPolicyPreparer(ctx context.Context, vaultBaseURL string, keyName string, keyRotationPolicy KeyRotationPolicy) (*http.Request, error) {
+	urlParameters := map[string]interface{}{
+		"vaultBaseUrl": vaultBaseURL,
+	}
+
+	pathParameters := map[string]interface{}{
+		"key-name": autorest.Encode("path", keyName),
+	}
+
+	queryParameters := map[string]interface{}{
+		"api-version": apiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithCustomBaseURL("{vaultBaseUrl}", urlParameters),
+		autorest.WithPathParameters("/keys/{key-name}/rotationpolicy", pathParameters),
+		autorest.WithJSON(keyRotationPolicy),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// UpdateKeyRotationPolicySender sends the UpdateKeyRotationPolicy request. The method will close the
+// http.Response Body if it receives an error.
+func (client BaseClient) UpdateKeyRotationPolicySender(req *http.Request) (*http.Response, error) {
+	return client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+}
+
+// UpdateKeyRotationPolicyResponder handles the response to the UpdateKeyRotationPolicy request. The method always
+// closes the http.Response Body.
+func (client BaseClient) UpdateKeyRotationPolicyResponder(resp *http.Response) (result KeyRotationPolicy, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	return
+}
@@ -0,0 +1,311 @@
+package keyvault
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See License.txt in the project root for license information.
+
+import (
+	"encoding/json"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/date"
+)
+
+// KeyRotationPolicyAction enumerates the values for key rotation policy action.
+type KeyRotationPolicyAction string
+
+const (
+	// KeyRotationPolicyActionNotify Trigger Event Grid events. For preview, lifetime actions of type Notify are
+	// only available on vaults and not managed HSMs.
+	KeyRotationPolicyActionNotify KeyRotationPolicyAction = "Notify"
+	// KeyRotationPolicyActionRotate Rotate the key based on the key policy.
+	KeyRotationPolicyActionRotate KeyRotationPolicyAction = "Rotate"
+)
+
+// PossibleKeyRotationPolicyActionValues returns an array of possible values for the KeyRotationPolicyAction const type.
+func PossibleKeyRotationPolicyActionValues() []KeyRotationPolicyAction {
+	return []KeyRotationPolicyAction{KeyRotationPolicyActionNotify, KeyRotationPolicyActionRotate}
+}
+
+// OperationStatus enumerates the values for operation status.
+type OperationStatus string
+
+const (
+	// OperationStatusFailed ...
+	OperationStatusFailed OperationStatus = "Failed"
+	// OperationStatusInProgress ...
+	OperationStatusInProgress OperationStatus = "InProgress"
+	// OperationStatusSuccess ...
+	OperationStatusSuccess OperationStatus = "Success"
+)
+
+// PossibleOperationStatusValues returns an array of possible values for the OperationStatus const type.
+func PossibleOperationStatusValues() []OperationStatus {
+	return []OperationStatus{OperationStatusFailed, OperationStatusInProgress, OperationStatusSuccess}
+}
+
+// RoleScope enumerates the values for role scope.
+type RoleScope string
+
+const (
+	// RoleScopeGlobal Global scope
+	RoleScopeGlobal RoleScope = "/"
+	// RoleScopeKeys Keys scope
+	RoleScopeKeys RoleScope = "/keys"
+)
+
+// PossibleRoleScopeValues returns an array of possible values for the RoleScope const type.
+func PossibleRoleScopeValues() []RoleScope {
+	return []RoleScope{RoleScopeGlobal, RoleScopeKeys}
+}
+
+// RoleType enumerates the values for role type.
+type RoleType string
+
+const (
+	// RoleTypeBuiltInRole Built in role.
+	RoleTypeBuiltInRole RoleType = "AKVBuiltInRole"
+	// RoleTypeCustomRole Custom role.
+	RoleTypeCustomRole RoleType = "CustomRole"
+)
+
+// PossibleRoleTypeValues returns an array of possible values for the RoleType const type.
+func PossibleRoleTypeValues() []RoleType {
+	return []RoleType{RoleTypeBuiltInRole, RoleTypeCustomRole}
+}
+
+// CertificateInfoObject the Security Domain download operation requires customer to provide N
+// certificates (minimum 3 and maximum 10) containing a public key in JWK format.
+type CertificateInfoObject struct {
+	// Certificates - Certificates needed from customer
+	Certificates *[]SecurityDomainJSONWebKey `json:"certificates,omitempty"`
+	// Required - Customer to specify the number of certificates (minimum 2 and maximum 10) to restore
+	// Security Domain
+	Required *int32 `json:"required,omitempty"`
+}
+
+// JSONWebKey as of http://tools.ietf.org/html/draft-ietf-jose-json-web-key-18
+type JSONWebKey struct {
+	// Kid - Key identifier.
+	Kid *string `json:"kid,omitempty"`
+}
+
+// KeyBundle a KeyBundle consisting of a WebKey plus its attributes.
+type KeyBundle struct {
+	autorest.Response `json:"-"`
+	// Key - The Json web key.
+	Key *JSONWebKey `json:"key,omitempty"`
+}
+
+// KeyRotationPolicy management policy for a key.
+type KeyRotationPolicy struct {
+	autorest.Response `json:"-"`
+	// ID - READ-ONLY; The key policy id.
+	ID *string `json:"id,omitempty"`
+	// LifetimeActions - Actions that will be performed by Key Vault over the lifetime of a key. For preview,
+	// lifetimeActions can only have two items at maximum: one for rotate, one for notify. Notification time
+	// would be default to 30 days before expiry and it is not configurable.
+	LifetimeActions *[]LifetimeActions `json:"lifetimeActions,omitempty"`
+	// Attributes - The key rotation policy attributes.
+	Attributes *KeyRotationPolicyAttributes `json:"attributes,omitempty"`
+}
+
+// MarshalJSON is the custom marshaler for KeyRotationPolicy.
+func (krp KeyRotationPolicy) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]interface{})
+	if krp.LifetimeActions != nil {
+		objectMap["lifetimeActions"] = krp.LifetimeActions
+	}
+	if krp.Attributes != nil {
+		objectMap["attributes"] = krp.Attributes
+	}
+	return json.Marshal(objectMap)
+}
+
+// KeyRotationPolicyAttributes the key rotation policy attributes.
+type KeyRotationPolicyAttributes struct {
+	// ExpiryTime - The expiryTime will be applied on the new key version. It should be at least 28 days. It
+	// will be in ISO 8601 Format. Examples: 90 days: P90D, 3 months: P3M, 48 hours: PT48H, 1 year and 10
+	// days: P1Y10D
+	ExpiryTime *string `json:"expiryTime,omitempty"`
+	// Created - READ-ONLY; The key rotation policy created time in UTC.
+	Created *date.UnixTime `json:"created,omitempty"`
+	// Updated - READ-ONLY; The key rotation policy's last updated time in UTC.
+	Updated *date.UnixTime `json:"updated,omitempty"`
+}
+
+// MarshalJSON is the custom marshaler for KeyRotationPolicyAttributes.
+func (krpa KeyRotationPolicyAttributes) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]interface{})
+	if krpa.ExpiryTime != nil {
+		objectMap["expiryTime"] = krpa.ExpiryTime
+	}
+	return json.Marshal(objectMap)
+}
+
+// LifetimeActions action and its trigger that will be performed by Key Vault over the lifetime of a key.
+type LifetimeActions struct {
+	// Trigger - The condition that will execute the action.
+	Trigger *LifetimeActionsTrigger `json:"trigger,omitempty"`
+	// Action - The action that will be executed.
+	Action *LifetimeActionsType `json:"action,omitempty"`
+}
+
+// LifetimeActionsTrigger a condition to be satisfied for an action to be executed.
+type LifetimeActionsTrigger struct {
+	// TimeAfterCreate - Time after creation to attempt to rotate. It only applies to rotate. It will be in
+	// ISO 8601 duration format. Example: 90 days : "P90D"
+	TimeAfterCreate *string `json:"timeAfterCreate,omitempty"`
+	// TimeBeforeExpiry - Time before expiry to attempt to rotate or notify. It will be in ISO 8601 duration
+	// format. Example: 90 days : "P90D"
+	TimeBeforeExpiry *string `json:"timeBeforeExpiry,omitempty"`
+}
+
+// LifetimeActionsType the action that will be executed.
+type LifetimeActionsType struct {
+	// Type - The type of the action. Possible values include: 'KeyRotationPolicyActionRotate',
+	// 'KeyRotationPolicyActionNotify'
+	Type KeyRotationPolicyAction `json:"type,omitempty"`
+}
+
+// Permission role definition permissions.
+type Permission struct {
+	// Actions - Action permissions that are granted.
+	Actions *[]string `json:"actions,omitempty"`
+	// NotActions - Action permissions that are excluded but not denied. They may be granted by other role
+	// definitions assigned to a principal.
+	NotActions *[]string `json:"notActions,omitempty"`
+	// DataActions - Data action permissions that are granted.
+	DataActions *[]string `json:"dataActions,omitempty"`
+	// NotDataActions - Data action permissions that are excluded but not denied. They may be granted by
+	// other role definitions assigned to a principal.
+	NotDataActions *[]string `json:"notDataActions,omitempty"`
+}
+
+// RoleAssignment role Assignments
+type RoleAssignment struct {
+	autorest.Response `json:"-"`
+	// ID - READ-ONLY; The role assignment ID.
+	ID *string `json:"id,omitempty"`
+	// Name - READ-ONLY; The role assignment name.
+	Name *string `json:"name,omitempty"`
+	// Type - READ-ONLY; The role assignment type.
+	Type *string `json:"type,omitempty"`
+	// Properties - Role assignment properties.
+	Properties *RoleAssignmentPropertiesWithScope `json:"properties,omitempty"`
+}
+
+// MarshalJSON is the custom marshaler for RoleAssignment.
+func (ra RoleAssignment) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]interface{})
+	if ra.Properties != nil {
+		objectMap["properties"] = ra.Properties
+	}
+	return json.Marshal(objectMap)
+}
+
+// RoleAssignmentCreateParameters role assignment create parameters.
+type RoleAssignmentCreateParameters struct {
+	// Properties - Role assignment properties.
+	Properties *RoleAssignmentProperties `json:"properties,omitempty"`
+}
+
+// RoleAssignmentProperties role assignment properties.
+type RoleAssignmentProperties struct {
+	// RoleDefinitionID - The role definition ID used in the role assignment.
+	RoleDefinitionID *string `json:"roleDefinitionId,omitempty"`
+	// PrincipalID - The principal ID assigned to the role. This maps to the ID inside the Active Directory.
+	// It can point to a user, service principal, or security group.
+	PrincipalID *string `json:"principalId,omitempty"`
+}
+
+// RoleAssignmentPropertiesWithScope role assignment properties with scope.
+type RoleAssignmentPropertiesWithScope struct {
+	// Scope - The role scope. Possible values include: 'RoleScopeGlobal', 'RoleScopeKeys'
+	Scope RoleScope `json:"scope,omitempty"`
+	// RoleDefinitionID - The role definition ID.
+	RoleDefinitionID *string `json:"roleDefinitionId,omitempty"`
+	// PrincipalID - The principal ID.
+	PrincipalID *string `json:"principalId,omitempty"`
+}
+
+// RoleDefinition role definition.
+type RoleDefinition struct {
+	autorest.Response `json:"-"`
+	// ID - READ-ONLY; The role definition ID.
+	ID *string `json:"id,omitempty"`
+	// Name - READ-ONLY; The role definition name.
+	Name *string `json:"name,omitempty"`
+	// Type - READ-ONLY; The role definition type.
+	Type *string `json:"type,omitempty"`
+	// Properties - Role definition properties.
+	Properties *RoleDefinitionProperties `json:"properties,omitempty"`
+}
+
+// MarshalJSON is the custom marshaler for RoleDefinition.
+func (rd RoleDefinition) MarshalJSON() ([]byte, error) {
+	objectMap := make(map[string]interface{})
+	if rd.Properties != nil {
+		objectMap["properties"] = rd.Properties
+	}
+	return json.Marshal(objectMap)
+}
+
+// RoleDefinitionCreateParameters role definition create parameters.
+type RoleDefinitionCreateParameters struct {
+	// Properties - Role definition properties.
+	Properties *RoleDefinitionProperties `json:"properties,omitempty"`
+}
+
+// RoleDefinitionProperties role definition properties.
+type RoleDefinitionProperties struct {
+	// RoleName - The role name.
+	RoleName *string `json:"roleName,omitempty"`
+	// Description - The role definition description.
+	Description *string `json:"description,omitempty"`
+	// RoleType - The role type. Possible values include: 'RoleTypeBuiltInRole', 'RoleTypeCustomRole'
+	RoleType RoleType `json:"type,omitempty"`
+	// Permissions - Role definition permissions.
+	Permissions *[]Permission `json:"permissions,omitempty"`
+	// AssignableScopes - Role definition assignable scopes.
+	AssignableScopes *[]RoleScope `json:"assignableScopes,omitempty"`
+}
+
+// SecurityDomainJSONWebKey a public key in JWK format used to encrypt the Security Domain.
+type SecurityDomainJSONWebKey struct {
+	// Kid - Key identifier.
+	Kid *string `json:"kid,omitempty"`
+	// Kty - JsonWebKey Key Type (kty), as defined in https://tools.ietf.org/html/draft-ietf-jose-json-web-algorithms-40. For Security Domain this value must be RSA.
+	Kty *string `json:"kty,omitempty"`
+	// KeyOps - Supported key operations.
+	KeyOps *[]string `json:"key_ops,omitempty"`
+	// N - RSA modulus.
+	N *string `json:"n,omitempty"`
+	// E - RSA public exponent.
+	E *string `json:"e,omitempty"`
+	// X5c - X509 certificate chain parameter
+	X5c *[]string `json:"x5c,omitempty"`
+	// Use - Public Key Use Parameter. This is optional and if present must be enc.
+	Use *string `json:"use,omitempty"`
+	// X5t - X509 certificate SHA1 thumbprint. This is optional.
+	X5t *string `json:"x5t,omitempty"`
+	// X5tS256 - X509 certificate SHA256 thumbprint.
+	X5tS256 *string `json:"x5t#S256,omitempty"`
+	// Alg - Algorithm intended for use with the key.
+	Alg *string `json:"alg,omitempty"`
+}
+
+// SecurityDomainObject the Security Domain.
+type SecurityDomainObject struct {
+	autorest.Response `json:"-"`
+	// Value - The Security Domain.
+	Value *string `json:"value,omitempty"`
+}
+
+// SecurityDomainOperationStatus ...
+type SecurityDomainOperationStatus struct {
+	autorest.Response `json:"-"`
+	// Status - operation status. Possible values include: 'OperationStatusSuccess', 'OperationStatusInProgress', 'OperationStatusFailed'
+	Status OperationStatus `json:"status,omitempty"`
+	// StatusDetails - Details of the operation status.
+	StatusDetails *string `json:"status_details,omitempty"`
+}
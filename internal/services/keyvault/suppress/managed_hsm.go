@@ -0,0 +1,12 @@
+package suppress
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// DiffSuppressManagedHSMBaseUrl ignores the trailing slash returned as a part of the Managed HSM URI
+func DiffSuppressManagedHSMBaseUrl(k, old, new string, d *pluginsdk.ResourceData) bool {
+	return strings.TrimSuffix(old, "/") == strings.TrimSuffix(new, "/")
+}
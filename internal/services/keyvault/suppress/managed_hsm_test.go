@@ -0,0 +1,38 @@
+package suppress
+
+import "testing"
+
+func TestSuppressManagedHSMBaseUrl(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Old      string
+		New      string
+		Suppress bool
+	}{
+		{
+			Name:     "same urls",
+			Old:      "https://test-hsm.managedhsm.azure.net",
+			New:      "https://test-hsm.managedhsm.azure.net",
+			Suppress: true,
+		},
+		{
+			Name:     "trailing slash",
+			Old:      "https://test-hsm.managedhsm.azure.net",
+			New:      "https://test-hsm.managedhsm.azure.net/",
+			Suppress: true,
+		},
+		{
+			Name:     "different urls",
+			Old:      "https://test-hsm.managedhsm.azure.net",
+			New:      "https://other-hsm.managedhsm.azure.net/",
+			Suppress: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %q..", tc.Name)
+		if DiffSuppressManagedHSMBaseUrl("", tc.Old, tc.New, nil) != tc.Suppress {
+			t.Fatalf("Expected %t but got %t", tc.Suppress, !tc.Suppress)
+		}
+	}
+}
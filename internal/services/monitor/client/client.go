@@ -9,13 +9,22 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/alertsmanagement/2021-08-08/alertprocessingrules"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-04-01/datacollectionendpoints"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-04-01/datacollectionruleassociations"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-04-01/datacollectionrules"
 	diagnosticSettingClient "github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-05-01-preview/diagnosticsettings"
 	diagnosticCategoryClient "github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-05-01-preview/diagnosticsettingscategories"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-08-01/scheduledqueryrules"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2022-06-01/datacollectionrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2023-03-01/prometheusrulegroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2023-04-03/azuremonitorworkspaces"
 )
 
+// NOTE: the Data Collection Rules SDK is embedded since the vendored SDK uses API Version `2021-04-01`, which doesn't
+// include Monitoring Account destinations or Prometheus Forwarder data sources - this should be switched back to the
+// vendored SDK once `go-azure-sdk` is bumped to a version which includes `insights/2022-06-01/datacollectionrules`
+
+// NOTE: the Prometheus Rule Groups and Azure Monitor Workspaces SDKs are embedded since the vendored SDK doesn't
+// include `Microsoft.AlertsManagement/prometheusRuleGroups` or `Microsoft.Monitor/accounts`
+
 type Client struct {
 	// AAD
 	AADDiagnosticSettingsClient *aad.DiagnosticSettingsClient
@@ -24,9 +33,10 @@ type Client struct {
 	AutoscaleSettingsClient *classic.AutoscaleSettingsClient
 
 	// alerts management
-	ActionRulesClient             *alertsmanagement.ActionRulesClient
-	AlertProcessingRulesClient    *alertprocessingrules.AlertProcessingRulesClient
-	SmartDetectorAlertRulesClient *alertsmanagement.SmartDetectorAlertRulesClient
+	ActionRulesClient              *alertsmanagement.ActionRulesClient
+	AlertPrometheusRuleGroupClient *prometheusrulegroups.PrometheusRuleGroupsClient
+	AlertProcessingRulesClient     *alertprocessingrules.AlertProcessingRulesClient
+	SmartDetectorAlertRulesClient  *alertsmanagement.SmartDetectorAlertRulesClient
 
 	// Monitor
 	ActionGroupsClient                   *newActionGroupClient.ActionGroupsClient
@@ -44,6 +54,7 @@ type Client struct {
 	PrivateLinkScopedResourcesClient     *classic.PrivateLinkScopedResourcesClient
 	ScheduledQueryRulesClient            *classic.ScheduledQueryRulesClient
 	ScheduledQueryRulesV2Client          *scheduledqueryrules.ScheduledQueryRulesClient
+	WorkspacesClient                     *azuremonitorworkspaces.AzureMonitorWorkspacesClient
 }
 
 func NewClient(o *common.ClientOptions) *Client {
@@ -56,6 +67,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	ActionRulesClient := alertsmanagement.NewActionRulesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&ActionRulesClient.Client, o.ResourceManagerAuthorizer)
 
+	AlertPrometheusRuleGroupClient := prometheusrulegroups.NewPrometheusRuleGroupsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&AlertPrometheusRuleGroupClient.Client, o.ResourceManagerAuthorizer)
+
 	AlertProcessingRulesClient := alertprocessingrules.NewAlertProcessingRulesClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&AlertProcessingRulesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -107,6 +121,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	ScheduledQueryRulesV2Client := scheduledqueryrules.NewScheduledQueryRulesClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&ScheduledQueryRulesV2Client.Client, o.ResourceManagerAuthorizer)
 
+	WorkspacesClient := azuremonitorworkspaces.NewAzureMonitorWorkspacesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&WorkspacesClient.Client, o.ResourceManagerAuthorizer)
+
 	return &Client{
 		AADDiagnosticSettingsClient:          &AADDiagnosticSettingsClient,
 		AutoscaleSettingsClient:              &AutoscaleSettingsClient,
@@ -117,6 +134,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		ActivityLogAlertsClient:              &ActivityLogAlertsClient,
 		AlertRulesClient:                     &AlertRulesClient,
 		AlertProcessingRulesClient:           &AlertProcessingRulesClient,
+		AlertPrometheusRuleGroupClient:       &AlertPrometheusRuleGroupClient,
 		DataCollectionEndpointsClient:        &DataCollectionEndpointsClient,
 		DataCollectionRuleAssociationsClient: &DataCollectionRuleAssociationsClient,
 		DataCollectionRulesClient:            &DataCollectionRulesClient,
@@ -128,5 +146,6 @@ func NewClient(o *common.ClientOptions) *Client {
 		PrivateLinkScopedResourcesClient:     &PrivateLinkScopedResourcesClient,
 		ScheduledQueryRulesClient:            &ScheduledQueryRulesClient,
 		ScheduledQueryRulesV2Client:          &ScheduledQueryRulesV2Client,
+		WorkspacesClient:                     &WorkspacesClient,
 	}
 }
@@ -0,0 +1,594 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2023-03-01/prometheusrulegroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type AlertPrometheusRuleGroupResourceModel struct {
+	Name              string                              `tfschema:"name"`
+	ResourceGroupName string                              `tfschema:"resource_group_name"`
+	ClusterName       string                              `tfschema:"cluster_name"`
+	Description       string                              `tfschema:"description"`
+	RuleGroupEnabled  bool                                `tfschema:"rule_group_enabled"`
+	Interval          string                              `tfschema:"interval"`
+	Location          string                              `tfschema:"location"`
+	Rules             []AlertPrometheusRuleGroupRuleModel `tfschema:"rule"`
+	Scopes            []string                            `tfschema:"scopes"`
+	Tags              map[string]string                   `tfschema:"tags"`
+}
+
+type AlertPrometheusRuleGroupRuleModel struct {
+	Actions              []AlertPrometheusRuleGroupActionModel               `tfschema:"action"`
+	Alert                string                                              `tfschema:"alert"`
+	Annotations          map[string]string                                   `tfschema:"annotations"`
+	Enabled              bool                                                `tfschema:"enabled"`
+	Expression           string                                              `tfschema:"expression"`
+	For                  string                                              `tfschema:"for"`
+	Labels               map[string]string                                   `tfschema:"labels"`
+	Record               string                                              `tfschema:"record"`
+	ResolveConfiguration []AlertPrometheusRuleGroupResolveConfigurationModel `tfschema:"alert_resolution"`
+	Severity             int64                                               `tfschema:"severity"`
+}
+
+type AlertPrometheusRuleGroupActionModel struct {
+	ActionGroupId    string            `tfschema:"action_group_id"`
+	ActionProperties map[string]string `tfschema:"action_properties"`
+}
+
+type AlertPrometheusRuleGroupResolveConfigurationModel struct {
+	AutoResolved  bool   `tfschema:"auto_resolved"`
+	TimeToResolve string `tfschema:"time_to_resolve"`
+}
+
+type AlertPrometheusRuleGroupResource struct{}
+
+var _ sdk.ResourceWithUpdate = AlertPrometheusRuleGroupResource{}
+
+func (r AlertPrometheusRuleGroupResource) ResourceType() string {
+	return "azurerm_monitor_alert_prometheus_rule_group"
+}
+
+func (r AlertPrometheusRuleGroupResource) ModelObject() interface{} {
+	return &AlertPrometheusRuleGroupResourceModel{}
+}
+
+func (r AlertPrometheusRuleGroupResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return prometheusrulegroups.ValidatePrometheusRuleGroupID
+}
+
+func (r AlertPrometheusRuleGroupResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"rule": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"expression": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"action": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"action_group_id": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+
+								"action_properties": {
+									Type:     pluginsdk.TypeMap,
+									Optional: true,
+									Elem: &pluginsdk.Schema{
+										Type: pluginsdk.TypeString,
+									},
+								},
+							},
+						},
+					},
+
+					"alert": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"alert_resolution": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"auto_resolved": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+								},
+
+								"time_to_resolve": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ValidateFunc: validate.ISO8601Duration,
+								},
+							},
+						},
+					},
+
+					"annotations": {
+						Type:     pluginsdk.TypeMap,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"enabled": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
+
+					"for": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validate.ISO8601Duration,
+					},
+
+					"labels": {
+						Type:     pluginsdk.TypeMap,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+
+					"record": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"severity": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntBetween(0, 4),
+					},
+				},
+			},
+		},
+
+		"scopes": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"cluster_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"interval": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validate.ISO8601Duration,
+		},
+
+		"rule_group_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r AlertPrometheusRuleGroupResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r AlertPrometheusRuleGroupResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model AlertPrometheusRuleGroupResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Monitor.AlertPrometheusRuleGroupClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+			id := prometheusrulegroups.NewPrometheusRuleGroupID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			properties := prometheusrulegroups.PrometheusRuleGroupResource{
+				Location: location.Normalize(model.Location),
+				Properties: prometheusrulegroups.PrometheusRuleGroupProperties{
+					Enabled: &model.RuleGroupEnabled,
+					Rules:   expandAlertPrometheusRuleGroupRuleModel(model.Rules),
+					Scopes:  model.Scopes,
+				},
+				Tags: &model.Tags,
+			}
+
+			if model.ClusterName != "" {
+				properties.Properties.ClusterName = &model.ClusterName
+			}
+
+			if model.Description != "" {
+				properties.Properties.Description = &model.Description
+			}
+
+			if model.Interval != "" {
+				properties.Properties.Interval = &model.Interval
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, properties); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r AlertPrometheusRuleGroupResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.AlertPrometheusRuleGroupClient
+
+			id, err := prometheusrulegroups.ParsePrometheusRuleGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var resourceModel AlertPrometheusRuleGroupResourceModel
+			if err := metadata.Decode(&resourceModel); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			model := resp.Model
+			if model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", id)
+			}
+
+			if metadata.ResourceData.HasChange("cluster_name") {
+				if resourceModel.ClusterName != "" {
+					model.Properties.ClusterName = &resourceModel.ClusterName
+				} else {
+					model.Properties.ClusterName = nil
+				}
+			}
+
+			if metadata.ResourceData.HasChange("description") {
+				if resourceModel.Description != "" {
+					model.Properties.Description = &resourceModel.Description
+				} else {
+					model.Properties.Description = nil
+				}
+			}
+
+			if metadata.ResourceData.HasChange("rule_group_enabled") {
+				model.Properties.Enabled = &resourceModel.RuleGroupEnabled
+			}
+
+			if metadata.ResourceData.HasChange("interval") {
+				if resourceModel.Interval != "" {
+					model.Properties.Interval = &resourceModel.Interval
+				} else {
+					model.Properties.Interval = nil
+				}
+			}
+
+			if metadata.ResourceData.HasChange("rule") {
+				model.Properties.Rules = expandAlertPrometheusRuleGroupRuleModel(resourceModel.Rules)
+			}
+
+			if metadata.ResourceData.HasChange("scopes") {
+				model.Properties.Scopes = resourceModel.Scopes
+			}
+
+			model.SystemData = nil
+
+			if metadata.ResourceData.HasChange("tags") {
+				model.Tags = &resourceModel.Tags
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, *model); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r AlertPrometheusRuleGroupResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.AlertPrometheusRuleGroupClient
+
+			id, err := prometheusrulegroups.ParsePrometheusRuleGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			model := resp.Model
+			if model == nil {
+				return fmt.Errorf("retrieving %s: model was nil", id)
+			}
+
+			state := AlertPrometheusRuleGroupResourceModel{
+				Name:              id.PrometheusRuleGroupName,
+				ResourceGroupName: id.ResourceGroupName,
+				Location:          location.Normalize(model.Location),
+			}
+
+			properties := &model.Properties
+			if properties.ClusterName != nil {
+				state.ClusterName = *properties.ClusterName
+			}
+
+			if properties.Description != nil {
+				state.Description = *properties.Description
+			}
+
+			if properties.Enabled != nil {
+				state.RuleGroupEnabled = *properties.Enabled
+			}
+
+			if properties.Interval != nil {
+				state.Interval = *properties.Interval
+			}
+
+			state.Rules = flattenAlertPrometheusRuleGroupRuleModel(properties.Rules)
+			state.Scopes = properties.Scopes
+
+			if model.Tags != nil {
+				state.Tags = *model.Tags
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r AlertPrometheusRuleGroupResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.AlertPrometheusRuleGroupClient
+
+			id, err := prometheusrulegroups.ParsePrometheusRuleGroupID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandAlertPrometheusRuleGroupRuleModel(inputList []AlertPrometheusRuleGroupRuleModel) []prometheusrulegroups.PrometheusRule {
+	outputList := make([]prometheusrulegroups.PrometheusRule, 0)
+	for _, v := range inputList {
+		input := v
+		output := prometheusrulegroups.PrometheusRule{
+			Actions:              expandAlertPrometheusRuleGroupActionModel(input.Actions),
+			Enabled:              &input.Enabled,
+			Expression:           input.Expression,
+			ResolveConfiguration: expandAlertPrometheusRuleGroupResolveConfigurationModel(input.ResolveConfiguration),
+		}
+
+		if input.Alert != "" {
+			output.Alert = &input.Alert
+			output.Severity = &input.Severity
+		}
+
+		if len(input.Annotations) > 0 {
+			output.Annotations = &input.Annotations
+		}
+
+		if input.For != "" {
+			output.For = &input.For
+		}
+
+		if len(input.Labels) > 0 {
+			output.Labels = &input.Labels
+		}
+
+		if input.Record != "" {
+			output.Record = &input.Record
+		}
+
+		outputList = append(outputList, output)
+	}
+
+	return outputList
+}
+
+func expandAlertPrometheusRuleGroupActionModel(inputList []AlertPrometheusRuleGroupActionModel) *[]prometheusrulegroups.PrometheusRuleGroupAction {
+	if len(inputList) == 0 {
+		return nil
+	}
+
+	outputList := make([]prometheusrulegroups.PrometheusRuleGroupAction, 0)
+	for _, v := range inputList {
+		input := v
+		output := prometheusrulegroups.PrometheusRuleGroupAction{
+			ActionGroupId:    &input.ActionGroupId,
+			ActionProperties: &input.ActionProperties,
+		}
+
+		outputList = append(outputList, output)
+	}
+
+	return &outputList
+}
+
+func expandAlertPrometheusRuleGroupResolveConfigurationModel(inputList []AlertPrometheusRuleGroupResolveConfigurationModel) *prometheusrulegroups.PrometheusRuleResolveConfiguration {
+	if len(inputList) == 0 {
+		return nil
+	}
+
+	input := &inputList[0]
+	output := prometheusrulegroups.PrometheusRuleResolveConfiguration{
+		AutoResolved: &input.AutoResolved,
+	}
+
+	if input.TimeToResolve != "" {
+		output.TimeToResolve = &input.TimeToResolve
+	}
+
+	return &output
+}
+
+func flattenAlertPrometheusRuleGroupRuleModel(inputList []prometheusrulegroups.PrometheusRule) []AlertPrometheusRuleGroupRuleModel {
+	outputList := make([]AlertPrometheusRuleGroupRuleModel, 0)
+	for _, input := range inputList {
+		output := AlertPrometheusRuleGroupRuleModel{
+			Actions:              flattenAlertPrometheusRuleGroupActionModel(input.Actions),
+			Expression:           input.Expression,
+			ResolveConfiguration: flattenAlertPrometheusRuleGroupResolveConfigurationModel(input.ResolveConfiguration),
+		}
+
+		if input.Alert != nil {
+			output.Alert = *input.Alert
+		}
+
+		if input.Annotations != nil {
+			output.Annotations = *input.Annotations
+		}
+
+		if input.Enabled != nil {
+			output.Enabled = *input.Enabled
+		}
+
+		if input.For != nil {
+			output.For = *input.For
+		}
+
+		if input.Labels != nil {
+			output.Labels = *input.Labels
+		}
+
+		if input.Record != nil {
+			output.Record = *input.Record
+		}
+
+		if input.Severity != nil {
+			output.Severity = *input.Severity
+		}
+
+		outputList = append(outputList, output)
+	}
+
+	return outputList
+}
+
+func flattenAlertPrometheusRuleGroupActionModel(inputList *[]prometheusrulegroups.PrometheusRuleGroupAction) []AlertPrometheusRuleGroupActionModel {
+	outputList := make([]AlertPrometheusRuleGroupActionModel, 0)
+	if inputList == nil {
+		return outputList
+	}
+
+	for _, input := range *inputList {
+		output := AlertPrometheusRuleGroupActionModel{}
+
+		if input.ActionGroupId != nil {
+			output.ActionGroupId = *input.ActionGroupId
+		}
+
+		if input.ActionProperties != nil {
+			output.ActionProperties = *input.ActionProperties
+		}
+
+		outputList = append(outputList, output)
+	}
+
+	return outputList
+}
+
+func flattenAlertPrometheusRuleGroupResolveConfigurationModel(input *prometheusrulegroups.PrometheusRuleResolveConfiguration) []AlertPrometheusRuleGroupResolveConfigurationModel {
+	outputList := make([]AlertPrometheusRuleGroupResolveConfigurationModel, 0)
+	if input == nil {
+		return outputList
+	}
+
+	output := AlertPrometheusRuleGroupResolveConfigurationModel{}
+	if input.AutoResolved != nil {
+		output.AutoResolved = *input.AutoResolved
+	}
+
+	if input.TimeToResolve != nil {
+		output.TimeToResolve = *input.TimeToResolve
+	}
+
+	return append(outputList, output)
+}
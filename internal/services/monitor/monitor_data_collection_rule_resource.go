@@ -8,12 +8,13 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2021-04-01/datacollectionrules"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2022-06-01/datacollectionrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2023-04-03/azuremonitorworkspaces"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -37,15 +38,17 @@ type DataFlow struct {
 }
 
 type DataSource struct {
-	Extensions          []Extension       `tfschema:"extension"`
-	PerformanceCounters []PerfCounter     `tfschema:"performance_counter"`
-	Syslog              []Syslog          `tfschema:"syslog"`
-	WindowsEventLogs    []WindowsEventLog `tfschema:"windows_event_log"`
+	Extensions          []Extension           `tfschema:"extension"`
+	PerformanceCounters []PerfCounter         `tfschema:"performance_counter"`
+	PrometheusForwarder []PrometheusForwarder `tfschema:"prometheus_forwarder"`
+	Syslog              []Syslog              `tfschema:"syslog"`
+	WindowsEventLogs    []WindowsEventLog     `tfschema:"windows_event_log"`
 }
 
 type Destination struct {
 	AzureMonitorMetrics []AzureMonitorMetric `tfschema:"azure_monitor_metrics"`
 	LogAnalytics        []LogAnalytic        `tfschema:"log_analytics"`
+	MonitorAccount      []MonitorAccount     `tfschema:"monitor_account"`
 }
 
 type Extension struct {
@@ -63,6 +66,17 @@ type PerfCounter struct {
 	Streams                    []string `tfschema:"streams"`
 }
 
+type PrometheusForwarder struct {
+	LabelIncludeFilter []LabelIncludeFilter `tfschema:"label_include_filter"`
+	Name               string               `tfschema:"name"`
+	Streams            []string             `tfschema:"streams"`
+}
+
+type LabelIncludeFilter struct {
+	Label string `tfschema:"label"`
+	Value string `tfschema:"value"`
+}
+
 type Syslog struct {
 	FacilityNames []string `tfschema:"facility_names"`
 	LogLevels     []string `tfschema:"log_levels"`
@@ -85,6 +99,11 @@ type LogAnalytic struct {
 	WorkspaceResourceId string `tfschema:"workspace_resource_id"`
 }
 
+type MonitorAccount struct {
+	MonitorAccountId string `tfschema:"monitor_account_id"`
+	Name             string `tfschema:"name"`
+}
+
 type DataCollectionRuleResource struct{}
 
 func (r DataCollectionRuleResource) Arguments() map[string]*pluginsdk.Schema {
@@ -148,7 +167,7 @@ func (r DataCollectionRuleResource) Arguments() map[string]*pluginsdk.Schema {
 								},
 							},
 						},
-						AtLeastOneOf: []string{"destinations.0.azure_monitor_metrics", "destinations.0.log_analytics"},
+						AtLeastOneOf: []string{"destinations.0.azure_monitor_metrics", "destinations.0.log_analytics", "destinations.0.monitor_account"},
 					},
 					"log_analytics": {
 						Type:     pluginsdk.TypeList,
@@ -167,7 +186,26 @@ func (r DataCollectionRuleResource) Arguments() map[string]*pluginsdk.Schema {
 								},
 							},
 						},
-						AtLeastOneOf: []string{"destinations.0.azure_monitor_metrics", "destinations.0.log_analytics"},
+						AtLeastOneOf: []string{"destinations.0.azure_monitor_metrics", "destinations.0.log_analytics", "destinations.0.monitor_account"},
+					},
+					"monitor_account": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*schema.Schema{
+								"monitor_account_id": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: azuremonitorworkspaces.ValidateAccountID,
+								},
+								"name": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+						AtLeastOneOf: []string{"destinations.0.azure_monitor_metrics", "destinations.0.log_analytics", "destinations.0.monitor_account"},
 					},
 				},
 			},
@@ -256,6 +294,47 @@ func (r DataCollectionRuleResource) Arguments() map[string]*pluginsdk.Schema {
 							},
 						},
 					},
+					"prometheus_forwarder": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+								"streams": {
+									Type:     pluginsdk.TypeList,
+									Required: true,
+									MinItems: 1,
+									Elem: &pluginsdk.Schema{
+										Type: pluginsdk.TypeString,
+										ValidateFunc: validation.StringInSlice(
+											datacollectionrules.PossibleValuesForKnownPrometheusForwarderDataSourceStreams(), false),
+									},
+								},
+								"label_include_filter": {
+									Type:     pluginsdk.TypeSet,
+									Optional: true,
+									Elem: &pluginsdk.Resource{
+										Schema: map[string]*pluginsdk.Schema{
+											"label": {
+												Type:         pluginsdk.TypeString,
+												Required:     true,
+												ValidateFunc: validation.StringIsNotEmpty,
+											},
+											"value": {
+												Type:         pluginsdk.TypeString,
+												Required:     true,
+												ValidateFunc: validation.StringIsNotEmpty,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
 					"syslog": {
 						Type:     pluginsdk.TypeList,
 						Optional: true,
@@ -612,6 +691,7 @@ func expandDataCollectionRuleDataSources(input []DataSource) (*datacollectionrul
 	return &datacollectionrules.DataSourcesSpec{
 		Extensions:          extension,
 		PerformanceCounters: expandDataCollectionRuleDataSourcePerfCounters(input[0].PerformanceCounters),
+		PrometheusForwarder: expandDataCollectionRuleDataSourcePrometheusForwarder(input[0].PrometheusForwarder),
 		Syslog:              expandDataCollectionRuleDataSourceSyslog(input[0].Syslog),
 		WindowsEventLogs:    expandDataCollectionRuleDataSourceWindowsEventLogs(input[0].WindowsEventLogs),
 	}, nil
@@ -685,6 +765,32 @@ func expandDataCollectionRuleDataSourcePerfCounterStreams(input []string) *[]dat
 	return &result
 }
 
+func expandDataCollectionRuleDataSourcePrometheusForwarder(input []PrometheusForwarder) *[]datacollectionrules.PrometheusForwarderDataSource {
+	if len(input) == 0 {
+		return nil
+	}
+
+	result := make([]datacollectionrules.PrometheusForwarderDataSource, 0)
+	for _, v := range input {
+		labelIncludeFilter := make(map[string]string)
+		for _, filter := range v.LabelIncludeFilter {
+			labelIncludeFilter[filter.Label] = filter.Value
+		}
+
+		streams := make([]datacollectionrules.KnownPrometheusForwarderDataSourceStreams, 0)
+		for _, stream := range v.Streams {
+			streams = append(streams, datacollectionrules.KnownPrometheusForwarderDataSourceStreams(stream))
+		}
+
+		result = append(result, datacollectionrules.PrometheusForwarderDataSource{
+			LabelIncludeFilter: &labelIncludeFilter,
+			Name:               utils.String(v.Name),
+			Streams:            &streams,
+		})
+	}
+	return &result
+}
+
 func expandDataCollectionRuleDataSourceSyslog(input []Syslog) *[]datacollectionrules.SyslogDataSource {
 	if len(input) == 0 {
 		return nil
@@ -777,6 +883,7 @@ func expandDataCollectionRuleDestinations(input []Destination) *datacollectionru
 	return &datacollectionrules.DestinationsSpec{
 		AzureMonitorMetrics: expandDataCollectionRuleDestinationMetrics(input[0].AzureMonitorMetrics),
 		LogAnalytics:        expandDataCollectionRuleDestinationLogAnalytics(input[0].LogAnalytics),
+		MonitoringAccounts:  expandDataCollectionRuleDestinationMonitorAccounts(input[0].MonitorAccount),
 	}
 }
 
@@ -805,6 +912,21 @@ func expandDataCollectionRuleDestinationLogAnalytics(input []LogAnalytic) *[]dat
 	return &result
 }
 
+func expandDataCollectionRuleDestinationMonitorAccounts(input []MonitorAccount) *[]datacollectionrules.MonitoringAccountDestination {
+	if len(input) == 0 {
+		return nil
+	}
+
+	result := make([]datacollectionrules.MonitoringAccountDestination, 0)
+	for _, v := range input {
+		result = append(result, datacollectionrules.MonitoringAccountDestination{
+			AccountResourceId: utils.String(v.MonitorAccountId),
+			Name:              utils.String(v.Name),
+		})
+	}
+	return &result
+}
+
 func flattenDataCollectionRuleKind(input *datacollectionrules.KnownDataCollectionRuleResourceKind) string {
 	if input == nil {
 		return ""
@@ -861,6 +983,7 @@ func flattenDataCollectionRuleDataSources(input *datacollectionrules.DataSources
 	return []DataSource{{
 		Extensions:          flattenDataCollectionRuleDataSourceExtensions(input.Extensions),
 		PerformanceCounters: flattenDataCollectionRuleDataSourcePerfCounters(input.PerformanceCounters),
+		PrometheusForwarder: flattenDataCollectionRuleDataSourcePrometheusForwarder(input.PrometheusForwarder),
 		Syslog:              flattenDataCollectionRuleDataSourceSyslog(input.Syslog),
 		WindowsEventLogs:    flattenDataCollectionRuleWindowsEventLogs(input.WindowsEventLogs),
 	}}
@@ -930,6 +1053,39 @@ func flattenDataCollectionRuleDataSourcePerfCounterStreams(input *[]datacollecti
 	return result
 }
 
+func flattenDataCollectionRuleDataSourcePrometheusForwarder(input *[]datacollectionrules.PrometheusForwarderDataSource) []PrometheusForwarder {
+	if input == nil {
+		return make([]PrometheusForwarder, 0)
+	}
+
+	result := make([]PrometheusForwarder, 0)
+	for _, v := range *input {
+		labelIncludeFilter := make([]LabelIncludeFilter, 0)
+		if v.LabelIncludeFilter != nil {
+			for label, value := range *v.LabelIncludeFilter {
+				labelIncludeFilter = append(labelIncludeFilter, LabelIncludeFilter{
+					Label: label,
+					Value: value,
+				})
+			}
+		}
+
+		streams := make([]string, 0)
+		if v.Streams != nil {
+			for _, stream := range *v.Streams {
+				streams = append(streams, string(stream))
+			}
+		}
+
+		result = append(result, PrometheusForwarder{
+			LabelIncludeFilter: labelIncludeFilter,
+			Name:               flattenStringPtr(v.Name),
+			Streams:            streams,
+		})
+	}
+	return result
+}
+
 func flattenDataCollectionRuleDataSourceSyslog(input *[]datacollectionrules.SyslogDataSource) []Syslog {
 	if input == nil {
 		return make([]Syslog, 0)
@@ -1019,6 +1175,7 @@ func flattenDataCollectionRuleDestinations(input *datacollectionrules.Destinatio
 	return []Destination{{
 		AzureMonitorMetrics: flattenDataCollectionRuleDestinationMetrics(input.AzureMonitorMetrics),
 		LogAnalytics:        flattenDataCollectionRuleDestinationLogAnalytics(input.LogAnalytics),
+		MonitorAccount:      flattenDataCollectionRuleDestinationMonitorAccounts(input.MonitoringAccounts),
 	}}
 }
 
@@ -1046,3 +1203,18 @@ func flattenDataCollectionRuleDestinationLogAnalytics(input *[]datacollectionrul
 	}
 	return result
 }
+
+func flattenDataCollectionRuleDestinationMonitorAccounts(input *[]datacollectionrules.MonitoringAccountDestination) []MonitorAccount {
+	if input == nil {
+		return make([]MonitorAccount, 0)
+	}
+
+	result := make([]MonitorAccount, 0)
+	for _, v := range *input {
+		result = append(result, MonitorAccount{
+			MonitorAccountId: flattenStringPtr(v.AccountResourceId),
+			Name:             flattenStringPtr(v.Name),
+		})
+	}
+	return result
+}
@@ -0,0 +1,210 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/sdk/2023-04-03/azuremonitorworkspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type WorkspaceResourceModel struct {
+	Name                            string                 `tfschema:"name"`
+	ResourceGroupName               string                 `tfschema:"resource_group_name"`
+	Location                        string                 `tfschema:"location"`
+	QueryEndpoint                   string                 `tfschema:"query_endpoint"`
+	DefaultDataCollectionEndpointId string                 `tfschema:"default_data_collection_endpoint_id"`
+	DefaultDataCollectionRuleId     string                 `tfschema:"default_data_collection_rule_id"`
+	Tags                            map[string]interface{} `tfschema:"tags"`
+}
+
+type WorkspaceResource struct{}
+
+var _ sdk.ResourceWithUpdate = WorkspaceResource{}
+
+func (r WorkspaceResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r WorkspaceResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"query_endpoint": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"default_data_collection_endpoint_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"default_data_collection_rule_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r WorkspaceResource) ResourceType() string {
+	return "azurerm_monitor_workspace"
+}
+
+func (r WorkspaceResource) ModelObject() interface{} {
+	return &WorkspaceResourceModel{}
+}
+
+func (r WorkspaceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return azuremonitorworkspaces.ValidateAccountID
+}
+
+func (r WorkspaceResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model WorkspaceResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.Monitor.WorkspacesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			id := azuremonitorworkspaces.NewAccountID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			properties := azuremonitorworkspaces.AzureMonitorWorkspaceResource{
+				Location: azure.NormalizeLocation(model.Location),
+				Tags:     tags.Expand(model.Tags),
+			}
+
+			if _, err := client.Create(ctx, id, properties); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r WorkspaceResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.WorkspacesClient
+
+			id, err := azuremonitorworkspaces.ParseAccountID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := WorkspaceResourceModel{
+				Name:              id.AccountName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = azure.NormalizeLocation(model.Location)
+				state.Tags = tags.Flatten(model.Tags)
+
+				if props := model.Properties; props != nil {
+					if metrics := props.Metrics; metrics != nil {
+						state.QueryEndpoint = flattenStringPtr(metrics.PrometheusQueryEndpoint)
+					}
+
+					if settings := props.DefaultIngestionSettings; settings != nil {
+						state.DefaultDataCollectionEndpointId = flattenStringPtr(settings.DataCollectionEndpointResourceId)
+						state.DefaultDataCollectionRuleId = flattenStringPtr(settings.DataCollectionRuleResourceId)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r WorkspaceResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.WorkspacesClient
+
+			id, err := azuremonitorworkspaces.ParseAccountID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model WorkspaceResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			properties := azuremonitorworkspaces.AzureMonitorWorkspaceResourceForUpdate{}
+			if metadata.ResourceData.HasChange("tags") {
+				properties.Tags = tags.Expand(model.Tags)
+			}
+
+			if _, err := client.Update(ctx, *id, properties); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r WorkspaceResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Monitor.WorkspacesClient
+
+			id, err := azuremonitorworkspaces.ParseAccountID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
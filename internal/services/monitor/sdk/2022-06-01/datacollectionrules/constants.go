@@ -73,11 +73,12 @@ func parseKnownDataCollectionRuleResourceKind(input string) (*KnownDataCollectio
 type KnownDataFlowStreams string
 
 const (
-	KnownDataFlowStreamsMicrosoftNegativeEvent           KnownDataFlowStreams = "Microsoft-Event"
-	KnownDataFlowStreamsMicrosoftNegativeInsightsMetrics KnownDataFlowStreams = "Microsoft-InsightsMetrics"
-	KnownDataFlowStreamsMicrosoftNegativePerf            KnownDataFlowStreams = "Microsoft-Perf"
-	KnownDataFlowStreamsMicrosoftNegativeSyslog          KnownDataFlowStreams = "Microsoft-Syslog"
-	KnownDataFlowStreamsMicrosoftNegativeWindowsEvent    KnownDataFlowStreams = "Microsoft-WindowsEvent"
+	KnownDataFlowStreamsMicrosoftNegativeEvent             KnownDataFlowStreams = "Microsoft-Event"
+	KnownDataFlowStreamsMicrosoftNegativeInsightsMetrics   KnownDataFlowStreams = "Microsoft-InsightsMetrics"
+	KnownDataFlowStreamsMicrosoftNegativePerf              KnownDataFlowStreams = "Microsoft-Perf"
+	KnownDataFlowStreamsMicrosoftNegativePrometheusMetrics KnownDataFlowStreams = "Microsoft-PrometheusMetrics"
+	KnownDataFlowStreamsMicrosoftNegativeSyslog            KnownDataFlowStreams = "Microsoft-Syslog"
+	KnownDataFlowStreamsMicrosoftNegativeWindowsEvent      KnownDataFlowStreams = "Microsoft-WindowsEvent"
 )
 
 func PossibleValuesForKnownDataFlowStreams() []string {
@@ -85,6 +86,7 @@ func PossibleValuesForKnownDataFlowStreams() []string {
 		string(KnownDataFlowStreamsMicrosoftNegativeEvent),
 		string(KnownDataFlowStreamsMicrosoftNegativeInsightsMetrics),
 		string(KnownDataFlowStreamsMicrosoftNegativePerf),
+		string(KnownDataFlowStreamsMicrosoftNegativePrometheusMetrics),
 		string(KnownDataFlowStreamsMicrosoftNegativeSyslog),
 		string(KnownDataFlowStreamsMicrosoftNegativeWindowsEvent),
 	}
@@ -92,11 +94,12 @@ func PossibleValuesForKnownDataFlowStreams() []string {
 
 func parseKnownDataFlowStreams(input string) (*KnownDataFlowStreams, error) {
 	vals := map[string]KnownDataFlowStreams{
-		"microsoft-event":           KnownDataFlowStreamsMicrosoftNegativeEvent,
-		"microsoft-insightsmetrics": KnownDataFlowStreamsMicrosoftNegativeInsightsMetrics,
-		"microsoft-perf":            KnownDataFlowStreamsMicrosoftNegativePerf,
-		"microsoft-syslog":          KnownDataFlowStreamsMicrosoftNegativeSyslog,
-		"microsoft-windowsevent":    KnownDataFlowStreamsMicrosoftNegativeWindowsEvent,
+		"microsoft-event":             KnownDataFlowStreamsMicrosoftNegativeEvent,
+		"microsoft-insightsmetrics":   KnownDataFlowStreamsMicrosoftNegativeInsightsMetrics,
+		"microsoft-perf":              KnownDataFlowStreamsMicrosoftNegativePerf,
+		"microsoft-prometheusmetrics": KnownDataFlowStreamsMicrosoftNegativePrometheusMetrics,
+		"microsoft-syslog":            KnownDataFlowStreamsMicrosoftNegativeSyslog,
+		"microsoft-windowsevent":      KnownDataFlowStreamsMicrosoftNegativeWindowsEvent,
 	}
 	if v, ok := vals[strings.ToLower(input)]; ok {
 		return &v, nil
@@ -172,6 +175,31 @@ func parseKnownPerfCounterDataSourceStreams(input string) (*KnownPerfCounterData
 	return &out, nil
 }
 
+type KnownPrometheusForwarderDataSourceStreams string
+
+const (
+	KnownPrometheusForwarderDataSourceStreamsMicrosoftNegativePrometheusMetrics KnownPrometheusForwarderDataSourceStreams = "Microsoft-PrometheusMetrics"
+)
+
+func PossibleValuesForKnownPrometheusForwarderDataSourceStreams() []string {
+	return []string{
+		string(KnownPrometheusForwarderDataSourceStreamsMicrosoftNegativePrometheusMetrics),
+	}
+}
+
+func parseKnownPrometheusForwarderDataSourceStreams(input string) (*KnownPrometheusForwarderDataSourceStreams, error) {
+	vals := map[string]KnownPrometheusForwarderDataSourceStreams{
+		"microsoft-prometheusmetrics": KnownPrometheusForwarderDataSourceStreamsMicrosoftNegativePrometheusMetrics,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := KnownPrometheusForwarderDataSourceStreams(input)
+	return &out, nil
+}
+
 type KnownSyslogDataSourceFacilityNames string
 
 const (
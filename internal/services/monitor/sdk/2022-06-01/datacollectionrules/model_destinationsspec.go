@@ -6,4 +6,5 @@ package datacollectionrules
 type DestinationsSpec struct {
 	AzureMonitorMetrics *AzureMonitorMetricsDestination `json:"azureMonitorMetrics,omitempty"`
 	LogAnalytics        *[]LogAnalyticsDestination      `json:"logAnalytics,omitempty"`
+	MonitoringAccounts  *[]MonitoringAccountDestination `json:"monitoringAccounts,omitempty"`
 }
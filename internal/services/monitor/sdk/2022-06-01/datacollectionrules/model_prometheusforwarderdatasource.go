@@ -0,0 +1,10 @@
+package datacollectionrules
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type PrometheusForwarderDataSource struct {
+	LabelIncludeFilter *map[string]string                           `json:"labelIncludeFilter,omitempty"`
+	Name               *string                                      `json:"name,omitempty"`
+	Streams            *[]KnownPrometheusForwarderDataSourceStreams `json:"streams,omitempty"`
+}
@@ -5,8 +5,8 @@ import "fmt"
 // Copyright (c) Microsoft Corporation. All rights reserved.
 // Licensed under the MIT License. See NOTICE.txt in the project root for license information.
 
-const defaultApiVersion = "2021-04-01"
+const defaultApiVersion = "2022-06-01"
 
 func userAgent() string {
-	return fmt.Sprintf("hashicorp/go-azure-sdk/datacollectionrules/%s", defaultApiVersion)
+	return fmt.Sprintf("pandora/datacollectionrules/%s", defaultApiVersion)
 }
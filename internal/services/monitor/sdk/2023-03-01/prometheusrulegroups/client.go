@@ -0,0 +1,18 @@
+package prometheusrulegroups
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type PrometheusRuleGroupsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewPrometheusRuleGroupsClientWithBaseURI(endpoint string) PrometheusRuleGroupsClient {
+	return PrometheusRuleGroupsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,12 @@
+package azuremonitorworkspaces
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type AzureMonitorWorkspace struct {
+	AccountId                *string                                        `json:"accountId,omitempty"`
+	DefaultIngestionSettings *AzureMonitorWorkspaceDefaultIngestionSettings `json:"defaultIngestionSettings,omitempty"`
+	Metrics                  *AzureMonitorWorkspaceMetrics                  `json:"metrics,omitempty"`
+	ProvisioningState        *ProvisioningState                             `json:"provisioningState,omitempty"`
+	PublicNetworkAccess      *PublicNetworkAccess                           `json:"publicNetworkAccess,omitempty"`
+}
@@ -0,0 +1,8 @@
+package azuremonitorworkspaces
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type AzureMonitorWorkspaceResourceForUpdate struct {
+	Tags *map[string]string `json:"tags,omitempty"`
+}
@@ -0,0 +1,12 @@
+package azuremonitorworkspaces
+
+import "fmt"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+const defaultApiVersion = "2023-04-03"
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/azuremonitorworkspaces/%s", defaultApiVersion)
+}
@@ -4,23 +4,33 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql" // nolint: staticcheck
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sqlvirtualmachine/2022-02-01/sqlvirtualmachines"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/sdk/2023-05-01-preview/databases"
 )
 
+// NOTE: the Databases SDK is embedded for the Export operation since the vendored SDK uses an API Version which doesn't
+// support Managed Identity authentication - API Version `2023-05-01-preview` is the first version to support this
+
 type Client struct {
 	BackupShortTermRetentionPoliciesClient             *sql.BackupShortTermRetentionPoliciesClient
 	DatabaseExtendedBlobAuditingPoliciesClient         *sql.ExtendedDatabaseBlobAuditingPoliciesClient
 	DatabaseSecurityAlertPoliciesClient                *sql.DatabaseSecurityAlertPoliciesClient
 	DatabaseVulnerabilityAssessmentRuleBaselinesClient *sql.DatabaseVulnerabilityAssessmentRuleBaselinesClient
 	DatabasesClient                                    *sql.DatabasesClient
+	DatabasesExportClient                              *databases.DatabasesClient
 	ElasticPoolsClient                                 *sql.ElasticPoolsClient
 	EncryptionProtectorClient                          *sql.EncryptionProtectorsClient
 	FailoverGroupsClient                               *sql.FailoverGroupsClient
 	FirewallRulesClient                                *sql.FirewallRulesClient
 	GeoBackupPoliciesClient                            *sql.GeoBackupPoliciesClient
 	InstanceFailoverGroupsClient                       *sql.InstanceFailoverGroupsClient
+	InstancePoolsClient                                *sql.InstancePoolsClient
 	JobAgentsClient                                    *sql.JobAgentsClient
 	JobCredentialsClient                               *sql.JobCredentialsClient
+	JobStepsClient                                     *sql.JobStepsClient
+	JobTargetGroupsClient                              *sql.JobTargetGroupsClient
+	JobsClient                                         *sql.JobsClient
 	LongTermRetentionPoliciesClient                    *sql.LongTermRetentionPoliciesClient
+	ManagedBackupShortTermRetentionPoliciesClient      *sql.ManagedBackupShortTermRetentionPoliciesClient
 	ManagedDatabasesClient                             *sql.ManagedDatabasesClient
 	ManagedInstancesClient                             *sql.ManagedInstancesClient
 	ManagedInstanceVulnerabilityAssessmentsClient      *sql.ManagedInstanceVulnerabilityAssessmentsClient
@@ -30,6 +40,7 @@ type Client struct {
 	ManagedInstanceAzureADOnlyAuthenticationsClient    *sql.ManagedInstanceAzureADOnlyAuthenticationsClient
 	ManagedInstanceEncryptionProtectorClient           *sql.ManagedInstanceEncryptionProtectorsClient
 	ManagedInstanceKeysClient                          *sql.ManagedInstanceKeysClient
+	ManagedInstanceLongTermRetentionPoliciesClient     *sql.ManagedInstanceLongTermRetentionPoliciesClient
 	ReplicationLinksClient                             *sql.ReplicationLinksClient
 	RestorableDroppedDatabasesClient                   *sql.RestorableDroppedDatabasesClient
 	ServerAzureADAdministratorsClient                  *sql.ServerAzureADAdministratorsClient
@@ -63,6 +74,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	databasesClient := sql.NewDatabasesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&databasesClient.Client, o.ResourceManagerAuthorizer)
 
+	databasesExportClient := databases.NewDatabasesClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&databasesExportClient.Client, o.ResourceManagerAuthorizer)
+
 	elasticPoolsClient := sql.NewElasticPoolsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&elasticPoolsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -81,15 +95,30 @@ func NewClient(o *common.ClientOptions) *Client {
 	instanceFailoverGroupsClient := sql.NewInstanceFailoverGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&instanceFailoverGroupsClient.Client, o.ResourceManagerAuthorizer)
 
+	instancePoolsClient := sql.NewInstancePoolsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&instancePoolsClient.Client, o.ResourceManagerAuthorizer)
+
 	jobAgentsClient := sql.NewJobAgentsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&jobAgentsClient.Client, o.ResourceManagerAuthorizer)
 
 	jobCredentialsClient := sql.NewJobCredentialsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&jobCredentialsClient.Client, o.ResourceManagerAuthorizer)
 
+	jobStepsClient := sql.NewJobStepsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&jobStepsClient.Client, o.ResourceManagerAuthorizer)
+
+	jobTargetGroupsClient := sql.NewJobTargetGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&jobTargetGroupsClient.Client, o.ResourceManagerAuthorizer)
+
+	jobsClient := sql.NewJobsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&jobsClient.Client, o.ResourceManagerAuthorizer)
+
 	longTermRetentionPoliciesClient := sql.NewLongTermRetentionPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&longTermRetentionPoliciesClient.Client, o.ResourceManagerAuthorizer)
 
+	managedBackupShortTermRetentionPoliciesClient := sql.NewManagedBackupShortTermRetentionPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&managedBackupShortTermRetentionPoliciesClient.Client, o.ResourceManagerAuthorizer)
+
 	managedDatabasesClient := sql.NewManagedDatabasesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&managedDatabasesClient.Client, o.ResourceManagerAuthorizer)
 
@@ -108,6 +137,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	managedInstanceKeysClient := sql.NewManagedInstanceKeysClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&managedInstanceKeysClient.Client, o.ResourceManagerAuthorizer)
 
+	managedInstanceLongTermRetentionPoliciesClient := sql.NewManagedInstanceLongTermRetentionPoliciesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&managedInstanceLongTermRetentionPoliciesClient.Client, o.ResourceManagerAuthorizer)
+
 	managedInstanceVulnerabilityAssessmentsClient := sql.NewManagedInstanceVulnerabilityAssessmentsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&managedInstanceVulnerabilityAssessmentsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -168,20 +200,27 @@ func NewClient(o *common.ClientOptions) *Client {
 		DatabaseSecurityAlertPoliciesClient:                &databaseSecurityAlertPoliciesClient,
 		DatabaseVulnerabilityAssessmentRuleBaselinesClient: &databaseVulnerabilityAssessmentRuleBaselinesClient,
 		DatabasesClient:                                  &databasesClient,
+		DatabasesExportClient:                            &databasesExportClient,
 		ElasticPoolsClient:                               &elasticPoolsClient,
 		EncryptionProtectorClient:                        &encryptionProtectorClient,
 		FailoverGroupsClient:                             &failoverGroupsClient,
 		FirewallRulesClient:                              &firewallRulesClient,
 		GeoBackupPoliciesClient:                          &geoBackupPoliciesClient,
 		InstanceFailoverGroupsClient:                     &instanceFailoverGroupsClient,
+		InstancePoolsClient:                              &instancePoolsClient,
 		JobAgentsClient:                                  &jobAgentsClient,
 		JobCredentialsClient:                             &jobCredentialsClient,
+		JobStepsClient:                                   &jobStepsClient,
+		JobTargetGroupsClient:                            &jobTargetGroupsClient,
+		JobsClient:                                       &jobsClient,
 		LongTermRetentionPoliciesClient:                  &longTermRetentionPoliciesClient,
+		ManagedBackupShortTermRetentionPoliciesClient:    &managedBackupShortTermRetentionPoliciesClient,
 		ManagedDatabasesClient:                           &managedDatabasesClient,
 		ManagedInstanceAdministratorsClient:              &managedInstancesAdministratorsClient,
 		ManagedInstanceAzureADOnlyAuthenticationsClient:  &managedInstanceAzureADOnlyAuthenticationsClient,
 		ManagedInstanceEncryptionProtectorClient:         &managedInstanceEncryptionProtectorsClient,
 		ManagedInstanceKeysClient:                        &managedInstanceKeysClient,
+		ManagedInstanceLongTermRetentionPoliciesClient:   &managedInstanceLongTermRetentionPoliciesClient,
 		ManagedInstanceServerSecurityAlertPoliciesClient: &managedInstanceServerSecurityAlertPoliciesClient,
 		ManagedInstanceVulnerabilityAssessmentsClient:    &managedInstanceVulnerabilityAssessmentsClient,
 		ManagedInstancesClient:                           &managedInstancesClient,
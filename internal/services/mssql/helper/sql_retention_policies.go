@@ -8,6 +8,13 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+type LongTermRetentionPolicy struct {
+	WeeklyRetention  string `tfschema:"weekly_retention"`
+	MonthlyRetention string `tfschema:"monthly_retention"`
+	YearlyRetention  string `tfschema:"yearly_retention"`
+	WeekOfYear       int    `tfschema:"week_of_year"`
+}
+
 func LongTermRetentionPolicySchema() *pluginsdk.Schema {
 	atLeastOneOf := []string{
 		"long_term_retention_policy.0.weekly_retention", "long_term_retention_policy.0.monthly_retention",
@@ -149,6 +156,72 @@ func FlattenLongTermRetentionPolicy(longTermRetentionPolicy *sql.LongTermRetenti
 	}
 }
 
+// ExpandLongTermRetentionPolicyModel is the typed equivalent of ExpandLongTermRetentionPolicy
+func ExpandLongTermRetentionPolicyModel(input []LongTermRetentionPolicy) *sql.BaseLongTermRetentionPolicyProperties {
+	if len(input) == 0 {
+		return nil
+	}
+
+	longTermRetentionPolicy := input[0]
+
+	longTermPolicyProperties := sql.BaseLongTermRetentionPolicyProperties{
+		WeeklyRetention:  utils.String("PT0S"),
+		MonthlyRetention: utils.String("PT0S"),
+		YearlyRetention:  utils.String("PT0S"),
+		WeekOfYear:       utils.Int32(1),
+	}
+
+	if v := longTermRetentionPolicy.WeeklyRetention; v != "" {
+		longTermPolicyProperties.WeeklyRetention = utils.String(v)
+	}
+
+	if v := longTermRetentionPolicy.MonthlyRetention; v != "" {
+		longTermPolicyProperties.MonthlyRetention = utils.String(v)
+	}
+
+	if v := longTermRetentionPolicy.YearlyRetention; v != "" {
+		longTermPolicyProperties.YearlyRetention = utils.String(v)
+	}
+
+	if v := longTermRetentionPolicy.WeekOfYear; v != 0 {
+		longTermPolicyProperties.WeekOfYear = utils.Int32(int32(v))
+	}
+
+	return &longTermPolicyProperties
+}
+
+// FlattenLongTermRetentionPolicyModel is the typed equivalent of FlattenLongTermRetentionPolicy
+func FlattenLongTermRetentionPolicyModel(input *sql.BaseLongTermRetentionPolicyProperties) []LongTermRetentionPolicy {
+	if input == nil {
+		return []LongTermRetentionPolicy{}
+	}
+
+	output := LongTermRetentionPolicy{
+		MonthlyRetention: "PT0S",
+		WeeklyRetention:  "PT0S",
+		WeekOfYear:       1,
+		YearlyRetention:  "PT0S",
+	}
+
+	if input.MonthlyRetention != nil {
+		output.MonthlyRetention = *input.MonthlyRetention
+	}
+
+	if input.WeeklyRetention != nil {
+		output.WeeklyRetention = *input.WeeklyRetention
+	}
+
+	if input.WeekOfYear != nil && *input.WeekOfYear != 0 {
+		output.WeekOfYear = int(*input.WeekOfYear)
+	}
+
+	if input.YearlyRetention != nil {
+		output.YearlyRetention = *input.YearlyRetention
+	}
+
+	return []LongTermRetentionPolicy{output}
+}
+
 func ExpandShortTermRetentionPolicy(input []interface{}) *sql.BackupShortTermRetentionPolicyProperties {
 	if len(input) == 0 || input[0] == nil {
 		return nil
@@ -0,0 +1,243 @@
+package mssql
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/sdk/2023-05-01-preview/databases"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
+	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceMsSqlDatabaseExport() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMsSqlDatabaseExportCreate,
+		Read:   resourceMsSqlDatabaseExportRead,
+		Delete: resourceMsSqlDatabaseExportDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
+			_, err := parse.DatabaseExportID(id)
+			return err
+		}, importMsSqlDatabaseExport),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(3 * time.Hour),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"database_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.DatabaseID,
+			},
+
+			"storage_uri": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsURLWithHTTPS,
+			},
+
+			"storage_key_type": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(databases.PossibleValuesForStorageKeyType(), false),
+			},
+
+			"storage_key": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"authentication_type": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"ADPassword",
+					"ManagedIdentity",
+					"Sql",
+				}, false),
+			},
+
+			"administrator_login": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"administrator_login_password": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"storage_account_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: storageValidate.StorageAccountID,
+			},
+
+			"blob_uri": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceMsSqlDatabaseExportCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.DatabasesExportClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	databaseId, err := parse.DatabaseID(d.Get("database_id").(string))
+	if err != nil {
+		return err
+	}
+
+	storageKeyType := d.Get("storage_key_type").(string)
+	authenticationType := d.Get("authentication_type").(string)
+	password := d.Get("administrator_login_password").(string)
+
+	// Managed Identity authentication uses the ID of the User Assigned Identity in place of both the key and the login
+	if storageKeyType == string(databases.StorageKeyTypeManagedIdentity) {
+		if _, err := commonids.ParseUserAssignedIdentityIDInsensitively(d.Get("storage_key").(string)); err != nil {
+			return fmt.Errorf("`storage_key` must be the ID of a User Assigned Identity when `storage_key_type` is `%s`: %+v", storageKeyType, err)
+		}
+	}
+	if strings.EqualFold(authenticationType, "ManagedIdentity") {
+		if _, err := commonids.ParseUserAssignedIdentityIDInsensitively(d.Get("administrator_login").(string)); err != nil {
+			return fmt.Errorf("`administrator_login` must be the ID of a User Assigned Identity when `authentication_type` is `ManagedIdentity`: %+v", err)
+		}
+	} else if password == "" {
+		return fmt.Errorf("`administrator_login_password` must be specified when `authentication_type` is `%s`", authenticationType)
+	}
+
+	parameters := databases.ExportDatabaseDefinition{
+		AdministratorLogin: d.Get("administrator_login").(string),
+		AuthenticationType: utils.String(authenticationType),
+		StorageKey:         d.Get("storage_key").(string),
+		StorageKeyType:     databases.StorageKeyType(storageKeyType),
+		StorageUri:         d.Get("storage_uri").(string),
+	}
+	if password != "" {
+		parameters.AdministratorLoginPassword = utils.String(password)
+	}
+	if storageAccountId, ok := d.GetOk("storage_account_id"); ok {
+		parameters.NetworkIsolation = &databases.NetworkIsolationSettings{
+			SqlServerResourceId:      utils.String(parse.NewServerID(databaseId.SubscriptionId, databaseId.ResourceGroup, databaseId.ServerName).ID()),
+			StorageAccountResourceId: utils.String(storageAccountId.(string)),
+		}
+	}
+
+	exportId := databases.NewDatabaseID(databaseId.SubscriptionId, databaseId.ResourceGroup, databaseId.ServerName, databaseId.Name)
+
+	log.Printf("[DEBUG] Exporting %s to %q..", *databaseId, parameters.StorageUri)
+	result, err := client.ExportThenPoll(ctx, exportId, parameters)
+	if err != nil {
+		return fmt.Errorf("exporting %s: %+v", *databaseId, err)
+	}
+
+	if result.Model == nil || result.Model.Properties == nil || result.Model.Properties.RequestId == nil {
+		return fmt.Errorf("exporting %s: `requestId` was nil", *databaseId)
+	}
+	props := result.Model.Properties
+	if status := utils.NormalizeNilableString(props.Status); strings.EqualFold(status, "Failed") {
+		return fmt.Errorf("exporting %s failed: %s", *databaseId, utils.NormalizeNilableString(props.ErrorMessage))
+	}
+
+	blobUri := parameters.StorageUri
+	if props.BlobUri != nil && *props.BlobUri != "" {
+		blobUri = *props.BlobUri
+	}
+	d.Set("blob_uri", blobUri)
+
+	id := parse.NewDatabaseExportID(databaseId.SubscriptionId, databaseId.ResourceGroup, databaseId.ServerName, databaseId.Name, *props.RequestId)
+	d.SetId(id.ID())
+
+	return resourceMsSqlDatabaseExportRead(d, meta)
+}
+
+func resourceMsSqlDatabaseExportRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.DatabasesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.DatabaseExportID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	databaseId := parse.NewDatabaseID(id.SubscriptionId, id.ResourceGroup, id.ServerName, id.DatabaseName)
+
+	// the API doesn't expose the details of an Export once it's completed, so the remaining values are retained from
+	// the state - however this is removed from the state once the Database has been deleted
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ServerName, id.DatabaseName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing %s from state", databaseId, *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", databaseId, err)
+	}
+
+	d.Set("database_id", databaseId.ID())
+
+	return nil
+}
+
+func importMsSqlDatabaseExport(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+	client := meta.(*clients.Client).MSSQL.DatabasesClient
+
+	id, err := parse.DatabaseExportID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	databaseId := parse.NewDatabaseID(id.SubscriptionId, id.ResourceGroup, id.ServerName, id.DatabaseName)
+
+	// the details of an Export aren't available once it's completed, so only the existence of the Database is checked
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ServerName, id.DatabaseName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return nil, fmt.Errorf("%s was not found", databaseId)
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", databaseId, err)
+	}
+
+	return []*pluginsdk.ResourceData{d}, nil
+}
+
+func resourceMsSqlDatabaseExportDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	id, err := parse.DatabaseExportID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// the exported bacpac is retained in the Storage Account, so this only removes the Export from the state
+	log.Printf("[DEBUG] %s can't be deleted - removing from state", *id)
+	return nil
+}
@@ -0,0 +1,251 @@
+package mssql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MsSqlDatabaseExportResource struct{}
+
+func TestAccMsSqlDatabaseExport_storageAccessKey(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_database_export", "test")
+	r := MsSqlDatabaseExportResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.storageAccessKey(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("blob_uri").IsSet(),
+			),
+		},
+		// only the `database_id` is available when importing
+		data.ImportStep("storage_uri", "storage_key_type", "storage_key", "authentication_type", "administrator_login", "administrator_login_password", "storage_account_id", "blob_uri"),
+	})
+}
+
+func TestAccMsSqlDatabaseExport_sharedAccessKey(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_database_export", "test")
+	r := MsSqlDatabaseExportResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.sharedAccessKey(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("blob_uri").IsSet(),
+			),
+		},
+	})
+}
+
+func TestAccMsSqlDatabaseExport_managedIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_database_export", "test")
+	r := MsSqlDatabaseExportResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.managedIdentity(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("blob_uri").IsSet(),
+			),
+		},
+	})
+}
+
+func (MsSqlDatabaseExportResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.DatabaseExportID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the Export itself can't be retrieved, so check the bacpac's parent Database exists
+	resp, err := client.MSSQL.DatabasesClient.Get(ctx, id.ResourceGroup, id.ServerName, id.DatabaseName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving Database for %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.ID != nil), nil
+}
+
+func (MsSqlDatabaseExportResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-mssql-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "bacpac"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
+func (r MsSqlDatabaseExportResource) sqlTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_server" "test" {
+  name                         = "acctest-sqlserver-%[2]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = azurerm_resource_group.test.location
+  version                      = "12.0"
+  administrator_login          = "mradministrator"
+  administrator_login_password = "thisIsDog11"
+}
+
+resource "azurerm_mssql_firewall_rule" "test" {
+  name             = "AllowAllAzureIps"
+  server_id        = azurerm_mssql_server.test.id
+  start_ip_address = "0.0.0.0"
+  end_ip_address   = "0.0.0.0"
+}
+
+resource "azurerm_mssql_database" "test" {
+  name      = "acctest-db-%[2]d"
+  server_id = azurerm_mssql_server.test.id
+  sku_name  = "S0"
+
+  depends_on = [azurerm_mssql_firewall_rule.test]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r MsSqlDatabaseExportResource) storageAccessKey(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_database_export" "test" {
+  database_id                  = azurerm_mssql_database.test.id
+  storage_uri                  = "${azurerm_storage_account.test.primary_blob_endpoint}${azurerm_storage_container.test.name}/export.bacpac"
+  storage_key_type             = "StorageAccessKey"
+  storage_key                  = azurerm_storage_account.test.primary_access_key
+  authentication_type          = "Sql"
+  administrator_login          = azurerm_mssql_server.test.administrator_login
+  administrator_login_password = azurerm_mssql_server.test.administrator_login_password
+}
+`, r.sqlTemplate(data))
+}
+
+func (r MsSqlDatabaseExportResource) sharedAccessKey(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_storage_account_blob_container_sas" "test" {
+  connection_string = azurerm_storage_account.test.primary_connection_string
+  container_name    = azurerm_storage_container.test.name
+  https_only        = true
+
+  start  = "2023-01-01"
+  expiry = "2099-01-01"
+
+  permissions {
+    read   = true
+    add    = true
+    create = true
+    write  = true
+    delete = false
+    list   = true
+  }
+}
+
+resource "azurerm_mssql_database_export" "test" {
+  database_id                  = azurerm_mssql_database.test.id
+  storage_uri                  = "${azurerm_storage_account.test.primary_blob_endpoint}${azurerm_storage_container.test.name}/export.bacpac"
+  storage_key_type             = "SharedAccessKey"
+  storage_key                  = data.azurerm_storage_account_blob_container_sas.test.sas
+  authentication_type          = "Sql"
+  administrator_login          = azurerm_mssql_server.test.administrator_login
+  administrator_login_password = azurerm_mssql_server.test.administrator_login_password
+}
+`, r.sqlTemplate(data))
+}
+
+func (r MsSqlDatabaseExportResource) managedIdentity(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctestuai%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_storage_account.test.id
+  role_definition_name = "Storage Blob Data Contributor"
+  principal_id         = azurerm_user_assigned_identity.test.principal_id
+}
+
+resource "azurerm_mssql_server" "test" {
+  name                              = "acctest-sqlserver-%[2]d"
+  resource_group_name               = azurerm_resource_group.test.name
+  location                          = azurerm_resource_group.test.location
+  version                           = "12.0"
+  primary_user_assigned_identity_id = azurerm_user_assigned_identity.test.id
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+
+  azuread_administrator {
+    login_username              = azurerm_user_assigned_identity.test.name
+    object_id                   = azurerm_user_assigned_identity.test.principal_id
+    azuread_authentication_only = true
+  }
+}
+
+resource "azurerm_mssql_firewall_rule" "test" {
+  name             = "AllowAllAzureIps"
+  server_id        = azurerm_mssql_server.test.id
+  start_ip_address = "0.0.0.0"
+  end_ip_address   = "0.0.0.0"
+}
+
+resource "azurerm_mssql_database" "test" {
+  name      = "acctest-db-%[2]d"
+  server_id = azurerm_mssql_server.test.id
+  sku_name  = "S0"
+
+  depends_on = [azurerm_mssql_firewall_rule.test]
+}
+
+resource "azurerm_mssql_database_export" "test" {
+  database_id         = azurerm_mssql_database.test.id
+  storage_uri         = "${azurerm_storage_account.test.primary_blob_endpoint}${azurerm_storage_container.test.name}/export.bacpac"
+  storage_key_type    = "ManagedIdentity"
+  storage_key         = azurerm_user_assigned_identity.test.id
+  authentication_type = "ManagedIdentity"
+  administrator_login = azurerm_user_assigned_identity.test.id
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.template(data), data.RandomInteger)
+}
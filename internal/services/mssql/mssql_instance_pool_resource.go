@@ -0,0 +1,292 @@
+package mssql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
+	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MsSqlInstancePoolModel struct {
+	Name              string            `tfschema:"name"`
+	ResourceGroupName string            `tfschema:"resource_group_name"`
+	Location          string            `tfschema:"location"`
+	LicenseType       string            `tfschema:"license_type"`
+	SkuName           string            `tfschema:"sku_name"`
+	SubnetId          string            `tfschema:"subnet_id"`
+	VCores            int               `tfschema:"vcores"`
+	Tags              map[string]string `tfschema:"tags"`
+}
+
+var _ sdk.ResourceWithUpdate = MsSqlInstancePoolResource{}
+
+type MsSqlInstancePoolResource struct{}
+
+func (r MsSqlInstancePoolResource) ResourceType() string {
+	return "azurerm_mssql_instance_pool"
+}
+
+func (r MsSqlInstancePoolResource) ModelObject() interface{} {
+	return &MsSqlInstancePoolModel{}
+}
+
+func (r MsSqlInstancePoolResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.InstancePoolID
+}
+
+func (r MsSqlInstancePoolResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ValidateMsSqlServerName,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"license_type": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(sql.InstancePoolLicenseTypeBasePrice),
+				string(sql.InstancePoolLicenseTypeLicenseIncluded),
+			}, false),
+		},
+
+		// only the General Purpose tier is supported for Instance Pools
+		"sku_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"GP_Gen5",
+				"GP_Gen8IH",
+				"GP_Gen8IM",
+			}, false),
+		},
+
+		"subnet_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: networkValidate.SubnetID,
+		},
+
+		"vcores": {
+			Type:     pluginsdk.TypeInt,
+			Required: true,
+			ValidateFunc: validation.IntInSlice([]int{
+				8,
+				16,
+				24,
+				32,
+				40,
+				64,
+				80,
+			}),
+		},
+
+		"tags": tags.Schema(),
+	}
+}
+
+func (r MsSqlInstancePoolResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r MsSqlInstancePoolResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 24 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.InstancePoolsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model MsSqlInstancePoolModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := parse.NewInstancePoolID(subscriptionId, model.ResourceGroupName, model.Name)
+
+			metadata.Logger.Infof("Import check for %s", id)
+			existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+			if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			sku, err := MsSqlManagedInstanceResource{}.expandSkuName(model.SkuName)
+			if err != nil {
+				return fmt.Errorf("expanding `sku_name` for %s: %v", id, err)
+			}
+
+			parameters := sql.InstancePool{
+				Sku:      sku,
+				Location: utils.String(location.Normalize(model.Location)),
+				InstancePoolProperties: &sql.InstancePoolProperties{
+					LicenseType: sql.InstancePoolLicenseType(model.LicenseType),
+					SubnetID:    utils.String(model.SubnetId),
+					VCores:      utils.Int32(int32(model.VCores)),
+				},
+				Tags: tags.FromTypedObject(model.Tags),
+			}
+
+			metadata.Logger.Infof("Creating %s", id)
+
+			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters)
+			if err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for creation of %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MsSqlInstancePoolResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 24 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.InstancePoolsClient
+
+			id, err := parse.InstancePoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model MsSqlInstancePoolModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if existing.InstancePoolProperties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", id)
+			}
+
+			// the PATCH operation only supports updating the tags, so the existing Instance Pool is updated using a PUT
+			parameters := existing
+			if metadata.ResourceData.HasChange("license_type") {
+				parameters.InstancePoolProperties.LicenseType = sql.InstancePoolLicenseType(model.LicenseType)
+			}
+
+			if metadata.ResourceData.HasChange("vcores") {
+				parameters.InstancePoolProperties.VCores = utils.Int32(int32(model.VCores))
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				parameters.Tags = tags.FromTypedObject(model.Tags)
+			}
+
+			metadata.Logger.Infof("Updating %s", id)
+
+			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters)
+			if err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for update of %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MsSqlInstancePoolResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.InstancePoolsClient
+
+			id, err := parse.InstancePoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+			if err != nil {
+				if utils.ResponseWasNotFound(existing.Response) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %v", id, err)
+			}
+
+			model := MsSqlInstancePoolModel{
+				Name:              id.Name,
+				ResourceGroupName: id.ResourceGroup,
+				Location:          location.NormalizeNilable(existing.Location),
+				Tags:              tags.ToTypedObject(existing.Tags),
+			}
+
+			if sku := existing.Sku; sku != nil && sku.Name != nil {
+				model.SkuName = MsSqlManagedInstanceResource{}.normalizeSku(*sku.Name)
+			}
+
+			if props := existing.InstancePoolProperties; props != nil {
+				model.LicenseType = string(props.LicenseType)
+
+				if props.SubnetID != nil {
+					model.SubnetId = *props.SubnetID
+				}
+				if props.VCores != nil {
+					model.VCores = int(*props.VCores)
+				}
+			}
+
+			return metadata.Encode(&model)
+		},
+	}
+}
+
+func (r MsSqlInstancePoolResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 24 * time.Hour,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.InstancePoolsClient
+
+			id, err := parse.InstancePoolID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+			if err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+				return fmt.Errorf("waiting for deletion of %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
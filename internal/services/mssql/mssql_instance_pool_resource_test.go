@@ -0,0 +1,186 @@
+package mssql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MsSqlInstancePoolResource struct{}
+
+func TestAccMsSqlInstancePool_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_instance_pool", "test")
+	r := MsSqlInstancePoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMsSqlInstancePool_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_instance_pool", "test")
+	r := MsSqlInstancePoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMsSqlInstancePool_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_instance_pool", "test")
+	r := MsSqlInstancePoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMsSqlInstancePool_withManagedInstance(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_instance_pool", "test")
+	r := MsSqlInstancePoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withManagedInstance(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_mssql_managed_instance.test").Key("instance_pool_id").Exists(),
+			),
+		},
+		data.ImportStep(),
+		data.ImportStepFor("azurerm_mssql_managed_instance.test", "administrator_login_password"),
+	})
+}
+
+func (r MsSqlInstancePoolResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.InstancePoolID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MSSQL.InstancePoolsClient.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r MsSqlInstancePoolResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_instance_pool" "test" {
+  name                = "acctest-pool-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  license_type        = "LicenseIncluded"
+  sku_name            = "GP_Gen5"
+  subnet_id           = azurerm_subnet.test.id
+  vcores              = 8
+
+  depends_on = [
+    azurerm_subnet_network_security_group_association.test,
+    azurerm_subnet_route_table_association.test,
+  ]
+}
+`, MsSqlManagedInstanceResource{}.template(data, data.Locations.Primary), data.RandomInteger)
+}
+
+func (r MsSqlInstancePoolResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_instance_pool" "import" {
+  name                = azurerm_mssql_instance_pool.test.name
+  resource_group_name = azurerm_mssql_instance_pool.test.resource_group_name
+  location            = azurerm_mssql_instance_pool.test.location
+  license_type        = azurerm_mssql_instance_pool.test.license_type
+  sku_name            = azurerm_mssql_instance_pool.test.sku_name
+  subnet_id           = azurerm_mssql_instance_pool.test.subnet_id
+  vcores              = azurerm_mssql_instance_pool.test.vcores
+}
+`, r.basic(data))
+}
+
+func (r MsSqlInstancePoolResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_instance_pool" "test" {
+  name                = "acctest-pool-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  license_type        = "BasePrice"
+  sku_name            = "GP_Gen5"
+  subnet_id           = azurerm_subnet.test.id
+  vcores              = 16
+
+  depends_on = [
+    azurerm_subnet_network_security_group_association.test,
+    azurerm_subnet_route_table_association.test,
+  ]
+
+  tags = {
+    environment = "staging"
+  }
+}
+`, MsSqlManagedInstanceResource{}.template(data, data.Locations.Primary), data.RandomInteger)
+}
+
+func (r MsSqlInstancePoolResource) withManagedInstance(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_managed_instance" "test" {
+  name                = "acctestsqlserver%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  instance_pool_id   = azurerm_mssql_instance_pool.test.id
+  license_type       = azurerm_mssql_instance_pool.test.license_type
+  sku_name           = azurerm_mssql_instance_pool.test.sku_name
+  storage_size_in_gb = 32
+  subnet_id          = azurerm_subnet.test.id
+  vcores             = 2
+
+  administrator_login          = "missadministrator"
+  administrator_login_password = "NCC-1701-D"
+}
+`, r.basic(data), data.RandomInteger)
+}
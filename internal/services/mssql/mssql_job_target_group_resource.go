@@ -0,0 +1,288 @@
+package mssql
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql" // nolint: staticcheck
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceMsSqlJobTargetGroup() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMsSqlJobTargetGroupCreateUpdate,
+		Read:   resourceMsSqlJobTargetGroupRead,
+		Update: resourceMsSqlJobTargetGroupCreateUpdate,
+		Delete: resourceMsSqlJobTargetGroupDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.JobTargetGroupID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"job_agent_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.JobAgentID,
+			},
+
+			"job_target": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"server_name": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: validate.ValidateMsSqlServerName,
+						},
+
+						"database_name": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.ValidateMsSqlDatabaseName,
+						},
+
+						"elastic_pool_name": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.ValidateMsSqlElasticPoolName,
+						},
+
+						"job_credential_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: validate.JobCredentialID,
+						},
+
+						"membership_type": {
+							Type:     pluginsdk.TypeString,
+							Optional: true,
+							Default:  string(sql.JobTargetGroupMembershipTypeInclude),
+							ValidateFunc: validation.StringInSlice([]string{
+								string(sql.JobTargetGroupMembershipTypeExclude),
+								string(sql.JobTargetGroupMembershipTypeInclude),
+							}, false),
+						},
+
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceMsSqlJobTargetGroupCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.JobTargetGroupsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Job Target Group creation.")
+
+	jaId, err := parse.JobAgentID(d.Get("job_agent_id").(string))
+	if err != nil {
+		return err
+	}
+	id := parse.NewJobTargetGroupID(jaId.SubscriptionId, jaId.ResourceGroup, jaId.ServerName, jaId.Name, d.Get("name").(string))
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, id.ResourceGroup, id.ServerName, id.JobAgentName, id.TargetGroupName)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing MsSql %s: %+v", id, err)
+			}
+		}
+
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return tf.ImportAsExistsError("azurerm_mssql_job_target_group", id.ID())
+		}
+	}
+
+	members, err := expandMsSqlJobTargets(d.Get("job_target").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	targetGroup := sql.JobTargetGroup{
+		JobTargetGroupProperties: &sql.JobTargetGroupProperties{
+			Members: members,
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ServerName, id.JobAgentName, id.TargetGroupName, targetGroup); err != nil {
+		return fmt.Errorf("creating/updating MsSql %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceMsSqlJobTargetGroupRead(d, meta)
+}
+
+func resourceMsSqlJobTargetGroupRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.JobTargetGroupsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.JobTargetGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ServerName, id.JobAgentName, id.TargetGroupName)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("name", id.TargetGroupName)
+	d.Set("job_agent_id", parse.NewJobAgentID(id.SubscriptionId, id.ResourceGroup, id.ServerName, id.JobAgentName).ID())
+
+	var members *[]sql.JobTarget
+	if props := resp.JobTargetGroupProperties; props != nil {
+		members = props.Members
+	}
+	if err := d.Set("job_target", flattenMsSqlJobTargets(members)); err != nil {
+		return fmt.Errorf("setting `job_target`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceMsSqlJobTargetGroupDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.JobTargetGroupsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.JobTargetGroupID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.Delete(ctx, id.ResourceGroup, id.ServerName, id.JobAgentName, id.TargetGroupName); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
+
+func expandMsSqlJobTargets(input []interface{}) (*[]sql.JobTarget, error) {
+	targets := make([]sql.JobTarget, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+
+		serverName := v["server_name"].(string)
+		databaseName := v["database_name"].(string)
+		elasticPoolName := v["elastic_pool_name"].(string)
+		credentialId := v["job_credential_id"].(string)
+		membershipType := sql.JobTargetGroupMembershipType(v["membership_type"].(string))
+
+		if databaseName != "" && elasticPoolName != "" {
+			return nil, fmt.Errorf("only one of `database_name` and `elastic_pool_name` can be specified for the `job_target` on server %q", serverName)
+		}
+
+		target := sql.JobTarget{
+			MembershipType: membershipType,
+			ServerName:     utils.String(serverName),
+		}
+
+		switch {
+		case databaseName != "":
+			target.Type = sql.JobTargetTypeSQLDatabase
+			target.DatabaseName = utils.String(databaseName)
+		case elasticPoolName != "":
+			target.Type = sql.JobTargetTypeSQLElasticPool
+			target.ElasticPoolName = utils.String(elasticPoolName)
+		default:
+			target.Type = sql.JobTargetTypeSQLServer
+		}
+
+		// the databases within a Server or Elastic Pool are enumerated at execution time, which requires a credential
+		if target.Type != sql.JobTargetTypeSQLDatabase && membershipType == sql.JobTargetGroupMembershipTypeInclude && credentialId == "" {
+			return nil, fmt.Errorf("`job_credential_id` must be specified for the `job_target` on server %q when including a Server or Elastic Pool", serverName)
+		}
+
+		if credentialId != "" {
+			target.RefreshCredential = utils.String(credentialId)
+		}
+
+		targets = append(targets, target)
+	}
+
+	return &targets, nil
+}
+
+func flattenMsSqlJobTargets(input *[]sql.JobTarget) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make([]interface{}, 0)
+	for _, item := range *input {
+		serverName := ""
+		if item.ServerName != nil {
+			serverName = *item.ServerName
+		}
+
+		databaseName := ""
+		if item.DatabaseName != nil {
+			databaseName = *item.DatabaseName
+		}
+
+		elasticPoolName := ""
+		if item.ElasticPoolName != nil {
+			elasticPoolName = *item.ElasticPoolName
+		}
+
+		credentialId := ""
+		if item.RefreshCredential != nil {
+			if id, err := parse.JobCredentialID(*item.RefreshCredential); err == nil {
+				credentialId = id.ID()
+			} else {
+				credentialId = *item.RefreshCredential
+			}
+		}
+
+		output = append(output, map[string]interface{}{
+			"server_name":       serverName,
+			"database_name":     databaseName,
+			"elastic_pool_name": elasticPoolName,
+			"job_credential_id": credentialId,
+			"membership_type":   string(item.MembershipType),
+			"type":              string(item.Type),
+		})
+	}
+
+	return output
+}
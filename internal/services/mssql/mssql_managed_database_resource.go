@@ -7,18 +7,22 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v5.0/sql" // nolint: staticcheck
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/helper"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sql/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
 type MsSqlManagedDatabaseModel struct {
-	Name              string `tfschema:"name"`
-	ManagedInstanceId string `tfschema:"managed_instance_id"`
+	Name                    string                           `tfschema:"name"`
+	ManagedInstanceId       string                           `tfschema:"managed_instance_id"`
+	LongTermRetentionPolicy []helper.LongTermRetentionPolicy `tfschema:"long_term_retention_policy"`
+	ShortTermRetentionDays  int                              `tfschema:"short_term_retention_days"`
 }
 
-var _ sdk.Resource = MsSqlManagedDatabaseResource{}
+var _ sdk.ResourceWithUpdate = MsSqlManagedDatabaseResource{}
 
 type MsSqlManagedDatabaseResource struct{}
 
@@ -49,6 +53,15 @@ func (r MsSqlManagedDatabaseResource) Arguments() map[string]*pluginsdk.Schema {
 			ForceNew:     true,
 			ValidateFunc: validate.ManagedInstanceID,
 		},
+
+		"long_term_retention_policy": helper.LongTermRetentionPolicySchema(),
+
+		"short_term_retention_days": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntBetween(1, 35),
+		},
 	}
 }
 
@@ -106,8 +119,21 @@ func (r MsSqlManagedDatabaseResource) Create() sdk.ResourceFunc {
 				return fmt.Errorf("waiting for creation of %s: %+v", id, err)
 			}
 
+			// the ID is set ahead of configuring the retention policies, so that the database is tracked if this fails
 			metadata.SetID(id)
 
+			if len(model.LongTermRetentionPolicy) > 0 {
+				if err := r.setLongTermRetentionPolicy(ctx, metadata, id, model.LongTermRetentionPolicy); err != nil {
+					return err
+				}
+			}
+
+			if model.ShortTermRetentionDays != 0 {
+				if err := r.setShortTermRetentionPolicy(ctx, metadata, id, model.ShortTermRetentionDays); err != nil {
+					return err
+				}
+			}
+
 			return nil
 		},
 	}
@@ -118,6 +144,8 @@ func (r MsSqlManagedDatabaseResource) Read() sdk.ResourceFunc {
 		Timeout: 5 * time.Minute,
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
 			client := metadata.Client.MSSQL.ManagedDatabasesClient
+			longTermRetentionClient := metadata.Client.MSSQL.ManagedInstanceLongTermRetentionPoliciesClient
+			shortTermRetentionClient := metadata.Client.MSSQL.ManagedBackupShortTermRetentionPoliciesClient
 
 			id, err := parse.ManagedDatabaseID(metadata.ResourceData.Id())
 			if err != nil {
@@ -145,11 +173,56 @@ func (r MsSqlManagedDatabaseResource) Read() sdk.ResourceFunc {
 				ManagedInstanceId: managedInstanceId.ID(),
 			}
 
+			longTermPolicy, err := longTermRetentionClient.Get(ctx, id.ResourceGroup, id.ManagedInstanceName, id.DatabaseName)
+			if err != nil {
+				return fmt.Errorf("retrieving Long Term Retention Policy for %s: %+v", id, err)
+			}
+			model.LongTermRetentionPolicy = helper.FlattenLongTermRetentionPolicyModel(longTermPolicy.BaseLongTermRetentionPolicyProperties)
+
+			shortTermPolicy, err := shortTermRetentionClient.Get(ctx, id.ResourceGroup, id.ManagedInstanceName, id.DatabaseName)
+			if err != nil {
+				return fmt.Errorf("retrieving Short Term Retention Policy for %s: %+v", id, err)
+			}
+			if props := shortTermPolicy.ManagedBackupShortTermRetentionPolicyProperties; props != nil && props.RetentionDays != nil {
+				model.ShortTermRetentionDays = int(*props.RetentionDays)
+			}
+
 			return metadata.Encode(&model)
 		},
 	}
 }
 
+func (r MsSqlManagedDatabaseResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			id, err := parse.ManagedDatabaseID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model MsSqlManagedDatabaseModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChange("long_term_retention_policy") {
+				if err := r.setLongTermRetentionPolicy(ctx, metadata, *id, model.LongTermRetentionPolicy); err != nil {
+					return err
+				}
+			}
+
+			if metadata.ResourceData.HasChange("short_term_retention_days") {
+				if err := r.setShortTermRetentionPolicy(ctx, metadata, *id, model.ShortTermRetentionDays); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
 func (r MsSqlManagedDatabaseResource) Delete() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -174,3 +247,43 @@ func (r MsSqlManagedDatabaseResource) Delete() sdk.ResourceFunc {
 		},
 	}
 }
+
+func (r MsSqlManagedDatabaseResource) setLongTermRetentionPolicy(ctx context.Context, metadata sdk.ResourceMetaData, id parse.ManagedDatabaseId, input []helper.LongTermRetentionPolicy) error {
+	client := metadata.Client.MSSQL.ManagedInstanceLongTermRetentionPoliciesClient
+
+	parameters := sql.ManagedInstanceLongTermRetentionPolicy{
+		BaseLongTermRetentionPolicyProperties: helper.ExpandLongTermRetentionPolicyModel(input),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ManagedInstanceName, id.DatabaseName, parameters)
+	if err != nil {
+		return fmt.Errorf("setting Long Term Retention Policy for %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of Long Term Retention Policy for %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+func (r MsSqlManagedDatabaseResource) setShortTermRetentionPolicy(ctx context.Context, metadata sdk.ResourceMetaData, id parse.ManagedDatabaseId, retentionDays int) error {
+	client := metadata.Client.MSSQL.ManagedBackupShortTermRetentionPoliciesClient
+
+	parameters := sql.ManagedBackupShortTermRetentionPolicy{
+		ManagedBackupShortTermRetentionPolicyProperties: &sql.ManagedBackupShortTermRetentionPolicyProperties{
+			RetentionDays: utils.Int32(int32(retentionDays)),
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.ManagedInstanceName, id.DatabaseName, parameters)
+	if err != nil {
+		return fmt.Errorf("setting Short Term Retention Policy for %s: %+v", id, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for update of Short Term Retention Policy for %s: %+v", id, err)
+	}
+
+	return nil
+}
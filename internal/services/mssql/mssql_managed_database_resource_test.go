@@ -29,6 +29,37 @@ func TestAccMsSqlManagedDatabase_basic(t *testing.T) {
 	})
 }
 
+func TestAccMsSqlManagedDatabase_withRetentionPolicies(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_managed_database", "test")
+	r := MsSqlManagedDatabase{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.withRetentionPolicies(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("short_term_retention_days").HasValue("10"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.withRetentionPoliciesUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("short_term_retention_days").HasValue("3"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r MsSqlManagedDatabase) Exists(ctx context.Context, client *clients.Client, state *acceptance.InstanceState) (*bool, error) {
 	id, err := parse.ManagedDatabaseID(state.ID)
 	if err != nil {
@@ -56,3 +87,41 @@ resource "azurerm_mssql_managed_database" "test" {
 }
 `, MsSqlManagedInstanceResource{}.basic(data), data.RandomInteger)
 }
+
+func (r MsSqlManagedDatabase) withRetentionPolicies(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_managed_database" "test" {
+  managed_instance_id       = azurerm_mssql_managed_instance.test.id
+  name                      = "acctest-%[2]d"
+  short_term_retention_days = 10
+
+  long_term_retention_policy {
+    weekly_retention  = "P1W"
+    monthly_retention = "P1M"
+    yearly_retention  = "P1Y"
+    week_of_year      = 1
+  }
+}
+`, MsSqlManagedInstanceResource{}.basic(data), data.RandomInteger)
+}
+
+func (r MsSqlManagedDatabase) withRetentionPoliciesUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_managed_database" "test" {
+  managed_instance_id       = azurerm_mssql_managed_instance.test.id
+  name                      = "acctest-%[2]d"
+  short_term_retention_days = 3
+
+  long_term_retention_policy {
+    weekly_retention  = "P10D"
+    monthly_retention = "P1Y"
+    yearly_retention  = "P10Y"
+    week_of_year      = 2
+  }
+}
+`, MsSqlManagedInstanceResource{}.basic(data), data.RandomInteger)
+}
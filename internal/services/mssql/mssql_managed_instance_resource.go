@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/maintenance/2022-07-01-preview/publicmaintenanceconfigurations"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	mssqlParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/sql/parse"
@@ -31,6 +32,7 @@ type MsSqlManagedInstanceModel struct {
 	DnsZonePartnerId             string                              `tfschema:"dns_zone_partner_id"`
 	Fqdn                         string                              `tfschema:"fqdn"`
 	Identity                     []identity.SystemOrUserAssignedList `tfschema:"identity"`
+	InstancePoolId               string                              `tfschema:"instance_pool_id"`
 	LicenseType                  string                              `tfschema:"license_type"`
 	Location                     string                              `tfschema:"location"`
 	MaintenanceConfigurationName string                              `tfschema:"maintenance_configuration_name"`
@@ -134,6 +136,7 @@ func (r MsSqlManagedInstanceResource) Arguments() map[string]*pluginsdk.Schema {
 			Type:     schema.TypeInt,
 			Required: true,
 			ValidateFunc: validation.IntInSlice([]int{
+				2,
 				4,
 				8,
 				16,
@@ -161,6 +164,13 @@ func (r MsSqlManagedInstanceResource) Arguments() map[string]*pluginsdk.Schema {
 
 		"identity": commonschema.SystemOrUserAssignedIdentityOptional(),
 
+		"instance_pool_id": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.InstancePoolID,
+		},
+
 		"maintenance_configuration_name": {
 			Type:     schema.TypeString,
 			Optional: true,
@@ -252,6 +262,11 @@ func (r MsSqlManagedInstanceResource) CustomizeDiff() sdk.ResourceFunc {
 				}
 			}
 
+			// 2 vCores are only supported for Managed Instances within an Instance Pool
+			if rd.Get("vcores").(int) == 2 && rd.Get("instance_pool_id").(string) == "" && rd.NewValueKnown("instance_pool_id") {
+				return fmt.Errorf("`vcores` can only be set to `2` when `instance_pool_id` is specified")
+			}
+
 			// system-assigned identity can't be removed due to https://github.com/Azure/azure-rest-api-specs/issues/16838
 			if oldVal, newVal := rd.GetChange("identity.#"); oldVal.(int) == 1 && newVal.(int) == 0 {
 				if err := rd.ForceNew("identity"); err != nil {
@@ -325,6 +340,10 @@ func (r MsSqlManagedInstanceResource) Create() sdk.ResourceFunc {
 				}
 			}
 
+			if model.InstancePoolId != "" {
+				parameters.ManagedInstanceProperties.InstancePoolID = utils.String(model.InstancePoolId)
+			}
+
 			metadata.Logger.Infof("Creating %s", id)
 
 			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters)
@@ -474,6 +493,13 @@ func (r MsSqlManagedInstanceResource) Read() sdk.ResourceFunc {
 				if props.FullyQualifiedDomainName != nil {
 					model.Fqdn = *props.FullyQualifiedDomainName
 				}
+				if props.InstancePoolID != nil {
+					instancePoolId, err := mssqlParse.InstancePoolIDInsensitively(*props.InstancePoolID)
+					if err != nil {
+						return err
+					}
+					model.InstancePoolId = instancePoolId.ID()
+				}
 				if props.MaintenanceConfigurationID != nil {
 					maintenanceConfigId, err := publicmaintenanceconfigurations.ParsePublicMaintenanceConfigurationID(*props.MaintenanceConfigurationID)
 					if err != nil {
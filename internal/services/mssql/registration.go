@@ -41,12 +41,16 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
 		"azurerm_mssql_database":                                        resourceMsSqlDatabase(),
+		"azurerm_mssql_database_export":                                 resourceMsSqlDatabaseExport(),
 		"azurerm_mssql_database_extended_auditing_policy":               resourceMsSqlDatabaseExtendedAuditingPolicy(),
 		"azurerm_mssql_database_vulnerability_assessment_rule_baseline": resourceMsSqlDatabaseVulnerabilityAssessmentRuleBaseline(),
 		"azurerm_mssql_elasticpool":                                     resourceMsSqlElasticPool(),
 		"azurerm_mssql_firewall_rule":                                   resourceMsSqlFirewallRule(),
+		"azurerm_mssql_job":                                             resourceMsSqlJob(),
 		"azurerm_mssql_job_agent":                                       resourceMsSqlJobAgent(),
 		"azurerm_mssql_job_credential":                                  resourceMsSqlJobCredential(),
+		"azurerm_mssql_job_step":                                        resourceMsSqlJobStep(),
+		"azurerm_mssql_job_target_group":                                resourceMsSqlJobTargetGroup(),
 		"azurerm_mssql_managed_instance_security_alert_policy":          resourceMsSqlManagedInstanceSecurityAlertPolicy(),
 		"azurerm_mssql_managed_instance_transparent_data_encryption":    resourceMsSqlManagedInstanceTransparentDataEncryption(),
 		"azurerm_mssql_managed_instance_vulnerability_assessment":       resourceMsSqlManagedInstanceVulnerabilityAssessment(),
@@ -73,6 +77,7 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		MsSqlFailoverGroupResource{},
+		MsSqlInstancePoolResource{},
 		MsSqlManagedDatabaseResource{},
 		MsSqlManagedInstanceActiveDirectoryAdministratorResource{},
 		MsSqlManagedInstanceFailoverGroupResource{},
@@ -0,0 +1,37 @@
+package databases
+
+import "strings"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type StorageKeyType string
+
+const (
+	StorageKeyTypeManagedIdentity  StorageKeyType = "ManagedIdentity"
+	StorageKeyTypeSharedAccessKey  StorageKeyType = "SharedAccessKey"
+	StorageKeyTypeStorageAccessKey StorageKeyType = "StorageAccessKey"
+)
+
+func PossibleValuesForStorageKeyType() []string {
+	return []string{
+		string(StorageKeyTypeManagedIdentity),
+		string(StorageKeyTypeSharedAccessKey),
+		string(StorageKeyTypeStorageAccessKey),
+	}
+}
+
+func parseStorageKeyType(input string) (*StorageKeyType, error) {
+	vals := map[string]StorageKeyType{
+		"managedidentity":  StorageKeyTypeManagedIdentity,
+		"sharedaccesskey":  StorageKeyTypeSharedAccessKey,
+		"storageaccesskey": StorageKeyTypeStorageAccessKey,
+	}
+	if v, ok := vals[strings.ToLower(input)]; ok {
+		return &v, nil
+	}
+
+	// otherwise presume it's an undefined value and best-effort it
+	out := StorageKeyType(input)
+	return &out, nil
+}
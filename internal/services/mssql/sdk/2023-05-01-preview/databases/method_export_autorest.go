@@ -0,0 +1,108 @@
+package databases
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/go-azure-helpers/polling"
+)
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type ExportOperationResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+	Model        *ImportExportOperationResult
+}
+
+// Export ...
+func (c DatabasesClient) Export(ctx context.Context, id DatabaseId, input ExportDatabaseDefinition) (result ExportOperationResponse, err error) {
+	req, err := c.preparerForExport(ctx, id, input)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "databases.DatabasesClient", "Export", nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderForExport(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "databases.DatabasesClient", "Export", result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// ExportThenPoll performs Export then polls until it's completed, returning the result of the Export
+func (c DatabasesClient) ExportThenPoll(ctx context.Context, id DatabaseId, input ExportDatabaseDefinition) (result ExportOperationResponse, err error) {
+	result, err = c.Export(ctx, id, input)
+	if err != nil {
+		return result, fmt.Errorf("performing Export: %+v", err)
+	}
+
+	if err = result.Poller.PollUntilDone(); err != nil {
+		return result, fmt.Errorf("polling after Export: %+v", err)
+	}
+
+	// the result of the Export is only available from the `Location` returned in the initial response
+	location := result.HttpResponse.Header.Get("Location")
+	if location == "" {
+		return result, nil
+	}
+
+	req, err := autorest.CreatePreparer(
+		autorest.AsGet(),
+		autorest.WithBaseURL(location)).Prepare((&http.Request{}).WithContext(ctx))
+	if err != nil {
+		return result, fmt.Errorf("preparing the request for the result of Export: %+v", err)
+	}
+
+	resp, err := c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return result, fmt.Errorf("retrieving the result of Export: %+v", err)
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Model),
+		autorest.ByClosing())
+	if err != nil {
+		return result, fmt.Errorf("retrieving the result of Export: %+v", err)
+	}
+
+	return result, nil
+}
+
+// preparerForExport prepares the Export request.
+func (c DatabasesClient) preparerForExport(ctx context.Context, id DatabaseId, input ExportDatabaseDefinition) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(fmt.Sprintf("%s/export", id.ID())),
+		autorest.WithJSON(input),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// senderForExport sends the Export request. The method will close the
+// http.Response Body if it receives an error.
+func (c DatabasesClient) senderForExport(ctx context.Context, req *http.Request) (future ExportOperationResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+
+	future.HttpResponse = resp
+	future.Poller, err = polling.NewPollerFromResponse(ctx, resp, c.Client, req.Method)
+	return
+}
@@ -0,0 +1,11 @@
+package databases
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type ImportExportOperationResult struct {
+	Id         *string                                `json:"id,omitempty"`
+	Name       *string                                `json:"name,omitempty"`
+	Properties *ImportExportOperationResultProperties `json:"properties,omitempty"`
+	Type       *string                                `json:"type,omitempty"`
+}
@@ -0,0 +1,9 @@
+package databases
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type NetworkIsolationSettings struct {
+	SqlServerResourceId      *string `json:"sqlServerResourceId,omitempty"`
+	StorageAccountResourceId *string `json:"storageAccountResourceId,omitempty"`
+}
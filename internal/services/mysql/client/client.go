@@ -6,13 +6,18 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mysql/2021-05-01/serverfailover"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mysql/2021-05-01/servers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mysql/sdk/2022-01-01/azureadadministrators"
 )
 
+// NOTE: the Azure AD Administrators SDK for Flexible Servers is embedded since neither of the vendored SDKs include it - API
+// Version `2022-01-01` is used since it's the first stable version to support Azure AD Administrators on Flexible Servers
+
 type Client struct {
 	ConfigurationsClient               *mysql.ConfigurationsClient
 	DatabasesClient                    *mysql.DatabasesClient
 	FirewallRulesClient                *mysql.FirewallRulesClient
 	FlexibleDatabasesClient            *mysqlflexibleservers.DatabasesClient
+	FlexibleServerAdministratorsClient *azureadadministrators.AzureADAdministratorsClient
 	FlexibleServerConfigurationsClient *mysqlflexibleservers.ConfigurationsClient
 	FlexibleServerClient               *servers.ServersClient
 	FlexibleServerFailoverClient       *serverfailover.ServerFailoverClient
@@ -37,6 +42,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	flexibleDatabasesClient := mysqlflexibleservers.NewDatabasesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&flexibleDatabasesClient.Client, o.ResourceManagerAuthorizer)
 
+	flexibleServerAdministratorsClient := azureadadministrators.NewAzureADAdministratorsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&flexibleServerAdministratorsClient.Client, o.ResourceManagerAuthorizer)
+
 	flexibleServerClient := servers.NewServersClientWithBaseURI(o.ResourceManagerEndpoint)
 	o.ConfigureClient(&flexibleServerClient.Client, o.ResourceManagerAuthorizer)
 
@@ -69,6 +77,7 @@ func NewClient(o *common.ClientOptions) *Client {
 		DatabasesClient:                    &DatabasesClient,
 		FirewallRulesClient:                &FirewallRulesClient,
 		FlexibleDatabasesClient:            &flexibleDatabasesClient,
+		FlexibleServerAdministratorsClient: &flexibleServerAdministratorsClient,
 		FlexibleServerClient:               &flexibleServerClient,
 		FlexibleServerFailoverClient:       &flexibleServerFailoverClient,
 		FlexibleServerFirewallRulesClient:  &flexibleServerFirewallRulesClient,
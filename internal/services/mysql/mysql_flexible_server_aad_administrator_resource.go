@@ -0,0 +1,177 @@
+package mysql
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mysql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mysql/sdk/2022-01-01/azureadadministrators"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mysql/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceMySQLFlexibleServerAdministrator() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceMySQLFlexibleServerAdministratorCreateUpdate,
+		Read:   resourceMySQLFlexibleServerAdministratorRead,
+		Update: resourceMySQLFlexibleServerAdministratorCreateUpdate,
+		Delete: resourceMySQLFlexibleServerAdministratorDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.FlexibleServerAzureActiveDirectoryAdministratorID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"server_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.FlexibleServerName,
+			},
+
+			"resource_group_name": commonschema.ResourceGroupName(),
+
+			// the User Assigned Identity the Flexible Server uses to query Microsoft Graph for the administrator
+			"identity_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+			},
+
+			"login": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"object_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"tenant_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+		},
+	}
+}
+
+func resourceMySQLFlexibleServerAdministratorCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MySQL.FlexibleServerAdministratorsClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewFlexibleServerAzureActiveDirectoryAdministratorID(subscriptionId, d.Get("resource_group_name").(string), d.Get("server_name").(string), "ActiveDirectory")
+	serverId := azureadadministrators.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroup, id.FlexibleServerName)
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, serverId)
+		if err != nil {
+			if !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+		}
+
+		if !response.WasNotFound(existing.HttpResponse) {
+			return tf.ImportAsExistsError("azurerm_mysql_flexible_server_active_directory_administrator", id.ID())
+		}
+	}
+
+	administratorType := azureadadministrators.AdministratorTypeActiveDirectory
+	parameters := azureadadministrators.AzureADAdministrator{
+		Properties: &azureadadministrators.AdministratorProperties{
+			AdministratorType:  &administratorType,
+			IdentityResourceId: utils.String(d.Get("identity_id").(string)),
+			Login:              utils.String(d.Get("login").(string)),
+			Sid:                utils.String(d.Get("object_id").(string)),
+			TenantId:           utils.String(d.Get("tenant_id").(string)),
+		},
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, serverId, parameters); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+	return resourceMySQLFlexibleServerAdministratorRead(d, meta)
+}
+
+func resourceMySQLFlexibleServerAdministratorRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MySQL.FlexibleServerAdministratorsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FlexibleServerAzureActiveDirectoryAdministratorID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, azureadadministrators.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroup, id.FlexibleServerName))
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[INFO] %s was not found - removing from state", *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("server_name", id.FlexibleServerName)
+
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			identityId := ""
+			if props.IdentityResourceId != nil {
+				parsed, err := commonids.ParseUserAssignedIdentityIDInsensitively(*props.IdentityResourceId)
+				if err != nil {
+					return fmt.Errorf("parsing %q: %+v", *props.IdentityResourceId, err)
+				}
+				identityId = parsed.ID()
+			}
+			d.Set("identity_id", identityId)
+			d.Set("login", props.Login)
+			d.Set("object_id", props.Sid)
+			d.Set("tenant_id", props.TenantId)
+		}
+	}
+
+	return nil
+}
+
+func resourceMySQLFlexibleServerAdministratorDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MySQL.FlexibleServerAdministratorsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FlexibleServerAzureActiveDirectoryAdministratorID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if err := client.DeleteThenPoll(ctx, azureadadministrators.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroup, id.FlexibleServerName)); err != nil {
+		return fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,229 @@
+package mysql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mysql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mysql/sdk/2022-01-01/azureadadministrators"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type MySqlFlexibleServerAdministratorResource struct{}
+
+func TestAccMySqlFlexibleServerAdministrator_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mysql_flexible_server_active_directory_administrator", "test")
+	r := MySqlFlexibleServerAdministratorResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMySqlFlexibleServerAdministrator_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mysql_flexible_server_active_directory_administrator", "test")
+	r := MySqlFlexibleServerAdministratorResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.requiresImport(data),
+			ExpectError: acceptance.RequiresImportError("azurerm_mysql_flexible_server_active_directory_administrator"),
+		},
+	})
+}
+
+func TestAccMySqlFlexibleServerAdministrator_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mysql_flexible_server_active_directory_administrator", "test")
+	r := MySqlFlexibleServerAdministratorResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.updateIdentity(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMySqlFlexibleServerAdministrator_aadAuthOnly(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mysql_flexible_server_active_directory_administrator", "test")
+	r := MySqlFlexibleServerAdministratorResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.aadAuthOnly(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_mysql_flexible_server_configuration.test").Key("value").HasValue("ON"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMySqlFlexibleServerAdministrator_disappears(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mysql_flexible_server_active_directory_administrator", "test")
+	r := MySqlFlexibleServerAdministratorResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		data.DisappearsStep(acceptance.DisappearsStepData{
+			Config:       r.basic,
+			TestResource: r,
+		}),
+	})
+}
+
+func (r MySqlFlexibleServerAdministratorResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.FlexibleServerAzureActiveDirectoryAdministratorID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.MySQL.FlexibleServerAdministratorsClient.Get(ctx, azureadadministrators.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroup, id.FlexibleServerName))
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r MySqlFlexibleServerAdministratorResource) Destroy(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.FlexibleServerAzureActiveDirectoryAdministratorID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := client.MySQL.FlexibleServerAdministratorsClient.DeleteThenPoll(ctx, azureadadministrators.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroup, id.FlexibleServerName)); err != nil {
+		return nil, fmt.Errorf("deleting %s: %+v", *id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (MySqlFlexibleServerAdministratorResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-mysql-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctestmi%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_user_assigned_identity" "test2" {
+  name                = "acctestmi2%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_mysql_flexible_server" "test" {
+  name                   = "acctest-fs-%[1]d"
+  resource_group_name    = azurerm_resource_group.test.name
+  location               = azurerm_resource_group.test.location
+  administrator_login    = "adminTerraform"
+  administrator_password = "QAZwsx123"
+  sku_name               = "B_Standard_B1s"
+  zone                   = "1"
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id, azurerm_user_assigned_identity.test2.id]
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r MySqlFlexibleServerAdministratorResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mysql_flexible_server_active_directory_administrator" "test" {
+  server_name         = azurerm_mysql_flexible_server.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  identity_id         = azurerm_user_assigned_identity.test.id
+  login               = "sqladmin"
+  object_id           = data.azurerm_client_config.current.client_id
+  tenant_id           = data.azurerm_client_config.current.tenant_id
+}
+`, r.template(data))
+}
+
+func (r MySqlFlexibleServerAdministratorResource) updateIdentity(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mysql_flexible_server_active_directory_administrator" "test" {
+  server_name         = azurerm_mysql_flexible_server.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  identity_id         = azurerm_user_assigned_identity.test2.id
+  login               = "sqladmin2"
+  object_id           = data.azurerm_client_config.current.client_id
+  tenant_id           = data.azurerm_client_config.current.tenant_id
+}
+`, r.template(data))
+}
+
+func (r MySqlFlexibleServerAdministratorResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mysql_flexible_server_active_directory_administrator" "import" {
+  server_name         = azurerm_mysql_flexible_server_active_directory_administrator.test.server_name
+  resource_group_name = azurerm_mysql_flexible_server_active_directory_administrator.test.resource_group_name
+  identity_id         = azurerm_mysql_flexible_server_active_directory_administrator.test.identity_id
+  login               = azurerm_mysql_flexible_server_active_directory_administrator.test.login
+  object_id           = azurerm_mysql_flexible_server_active_directory_administrator.test.object_id
+  tenant_id           = azurerm_mysql_flexible_server_active_directory_administrator.test.tenant_id
+}
+`, r.basic(data))
+}
+
+func (r MySqlFlexibleServerAdministratorResource) aadAuthOnly(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mysql_flexible_server_configuration" "test" {
+  name                = "aad_auth_only"
+  resource_group_name = azurerm_resource_group.test.name
+  server_name         = azurerm_mysql_flexible_server.test.name
+  value               = "ON"
+
+  depends_on = [azurerm_mysql_flexible_server_active_directory_administrator.test]
+}
+`, r.basic(data))
+}
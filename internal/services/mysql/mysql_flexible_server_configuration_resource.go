@@ -3,12 +3,15 @@ package mysql
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/mysql/mgmt/2021-05-01/mysqlflexibleservers" // nolint: staticcheck
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mysql/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mysql/sdk/2022-01-01/azureadadministrators"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mysql/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
@@ -76,6 +79,21 @@ func resourceMySQLFlexibleServerConfigurationCreate(d *pluginsdk.ResourceData, m
 	//       since a fallback route is created by default
 
 	id := parse.NewFlexibleServerConfigurationID(subscriptionId, d.Get("resource_group_name").(string), d.Get("server_name").(string), d.Get("name").(string))
+
+	// enabling `aad_auth_only` disables password authentication, as such an Azure AD Administrator must exist
+	// beforehand otherwise nobody would be able to log into the Flexible Server
+	if strings.EqualFold(id.ConfigurationName, "aad_auth_only") && strings.EqualFold(d.Get("value").(string), "ON") {
+		administratorsClient := meta.(*clients.Client).MySQL.FlexibleServerAdministratorsClient
+		serverId := azureadadministrators.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroup, id.FlexibleServerName)
+		resp, err := administratorsClient.Get(ctx, serverId)
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return fmt.Errorf("`aad_auth_only` can only be enabled once an Azure Active Directory Administrator has been configured for %s", serverId)
+			}
+			return fmt.Errorf("retrieving Azure Active Directory Administrator for %s: %+v", serverId, err)
+		}
+	}
+
 	future, err := client.Update(ctx, id.ResourceGroup, id.FlexibleServerName, id.ConfigurationName, properties)
 	if err != nil {
 		return fmt.Errorf("creating %s: %v", id, err)
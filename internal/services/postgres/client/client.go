@@ -15,10 +15,14 @@ import (
 	flexibleserverfirewallrules "github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2021-06-01/firewallrules"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2021-06-01/serverrestart"
 	flexibleserveradministrators "github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2022-03-08-preview/administrators"
-	flexibleservers "github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2022-03-08-preview/servers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	flexibleservers "github.com/hashicorp/terraform-provider-azurerm/internal/services/postgres/sdk/2022-12-01/servers"
 )
 
+// NOTE: the Flexible Servers SDK is embedded since the vendored `postgresql/2022-12-01/servers` SDK doesn't include
+// the geo-backup Customer Managed Key fields within `DataEncryption` - this is otherwise identical to the vendored SDK
+// and should be switched back once `go-azure-sdk` is bumped to a version which includes these fields
+
 type Client struct {
 	ConfigurationsClient                *configurations.ConfigurationsClient
 	DatabasesClient                     *databases.DatabasesClient
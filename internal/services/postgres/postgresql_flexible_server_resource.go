@@ -1,21 +1,27 @@
 package postgres
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2021-06-01/serverrestart"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/postgresql/2022-03-08-preview/servers"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2018-09-01/privatezones"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/postgres/sdk/2022-12-01/servers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/postgres/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -121,7 +127,6 @@ func resourcePostgresqlFlexibleServer() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
 				Computed:     true,
-				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice(servers.PossibleValuesForServerVersion(), false),
 			},
 
@@ -133,10 +138,58 @@ func resourcePostgresqlFlexibleServer() *pluginsdk.Resource {
 				ForceNew: true,
 				ValidateFunc: validation.StringInSlice([]string{
 					string(servers.CreateModeDefault),
+					string(servers.CreateModeGeoRestore),
 					string(servers.CreateModePointInTimeRestore),
+					string(servers.CreateModeReplica),
 				}, false),
 			},
 
+			"customer_managed_key": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"key_vault_key_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+							RequiredWith: []string{
+								"identity",
+								"customer_managed_key.0.primary_user_assigned_identity_id",
+							},
+						},
+
+						"primary_user_assigned_identity_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+						},
+
+						"geo_backup_key_vault_key_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+							RequiredWith: []string{
+								"customer_managed_key.0.geo_backup_user_assigned_identity_id",
+							},
+						},
+
+						"geo_backup_user_assigned_identity_id": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+							RequiredWith: []string{
+								"customer_managed_key.0.geo_backup_key_vault_key_id",
+							},
+						},
+					},
+				},
+			},
+
+			"identity": commonschema.UserAssignedIdentityOptional(),
+
 			"delegated_subnet_id": {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
@@ -232,6 +285,15 @@ func resourcePostgresqlFlexibleServer() *pluginsdk.Resource {
 				},
 			},
 
+			"replication_role": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(servers.ReplicationRoleNone),
+				}, false),
+			},
+
 			"fqdn": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -244,6 +306,8 @@ func resourcePostgresqlFlexibleServer() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourcePostgresqlFlexibleServerVersionUpgradeDiff),
 	}
 }
 
@@ -270,13 +334,26 @@ func resourcePostgresqlFlexibleServerCreate(d *pluginsdk.ResourceData, meta inte
 
 	createMode := d.Get("create_mode").(string)
 
-	if servers.CreateMode(createMode) == servers.CreateModePointInTimeRestore {
+	switch servers.CreateMode(createMode) {
+	case servers.CreateModeGeoRestore, servers.CreateModePointInTimeRestore:
 		if _, ok := d.GetOk("source_server_id"); !ok {
-			return fmt.Errorf("`source_server_id` is required when `create_mode` is `PointInTimeRestore`")
+			return fmt.Errorf("`source_server_id` is required when `create_mode` is `%s`", createMode)
 		}
 		if _, ok := d.GetOk("point_in_time_restore_time_in_utc"); !ok {
-			return fmt.Errorf("`point_in_time_restore_time_in_utc` is required when `create_mode` is `PointInTimeRestore`")
+			return fmt.Errorf("`point_in_time_restore_time_in_utc` is required when `create_mode` is `%s`", createMode)
 		}
+	case servers.CreateModeReplica:
+		if _, ok := d.GetOk("source_server_id"); !ok {
+			return fmt.Errorf("`source_server_id` is required when `create_mode` is `Replica`")
+		}
+	}
+
+	if _, ok := d.GetOk("replication_role"); ok {
+		return fmt.Errorf("`replication_role` cannot be set while creating")
+	}
+
+	if _, ok := d.GetOk("customer_managed_key.0.geo_backup_key_vault_key_id"); ok && !d.Get("geo_redundant_backup_enabled").(bool) {
+		return fmt.Errorf("`geo_redundant_backup_enabled` must be set to `true` when `customer_managed_key.0.geo_backup_key_vault_key_id` is specified")
 	}
 
 	if createMode == "" || servers.CreateMode(createMode) == servers.CreateModeDefault {
@@ -309,11 +386,20 @@ func resourcePostgresqlFlexibleServerCreate(d *pluginsdk.ResourceData, meta inte
 			Storage:          expandArmServerStorage(d),
 			HighAvailability: expandFlexibleServerHighAvailability(d.Get("high_availability").([]interface{}), true),
 			Backup:           expandArmServerBackup(d),
+			DataEncryption:   expandFlexibleServerDataEncryption(d.Get("customer_managed_key").([]interface{})),
 		},
 		Sku:  sku,
 		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
+	if v, ok := d.GetOk("identity"); ok {
+		identity, err := expandFlexibleServerIdentity(v.([]interface{}))
+		if err != nil {
+			return fmt.Errorf("expanding `identity`: %+v", err)
+		}
+		parameters.Identity = identity
+	}
+
 	if v, ok := d.GetOk("administrator_login"); ok && v.(string) != "" {
 		parameters.Properties.AdministratorLogin = utils.String(v.(string))
 	}
@@ -353,7 +439,7 @@ func resourcePostgresqlFlexibleServerCreate(d *pluginsdk.ResourceData, meta inte
 	// so we create it with  `password_auth_enabled` set to `true`, then set it to `false` in an additional update.
 	if authRaw, ok := d.GetOk("authentication"); ok {
 		authConfig := expandFlexibleServerAuthConfig(authRaw.([]interface{}))
-		authConfig.PasswordAuthEnabled = utils.Bool(true)
+		authConfig.PasswordAuth = pointer.To(servers.PasswordAuthEnumEnabled)
 		parameters.Properties.AuthConfig = authConfig
 	}
 
@@ -365,7 +451,7 @@ func resourcePostgresqlFlexibleServerCreate(d *pluginsdk.ResourceData, meta inte
 	updateProperties := servers.ServerPropertiesForUpdate{}
 	if authRaw, ok := d.GetOk("authentication"); ok {
 		authConfig := expandFlexibleServerAuthConfig(authRaw.([]interface{}))
-		if !*authConfig.PasswordAuthEnabled {
+		if *authConfig.PasswordAuth == servers.PasswordAuthEnumDisabled {
 			requireAdditionalUpdate = true
 			updateProperties.AuthConfig = authConfig
 		}
@@ -422,6 +508,7 @@ func resourcePostgresqlFlexibleServerRead(d *pluginsdk.ResourceData, meta interf
 			d.Set("zone", props.AvailabilityZone)
 			d.Set("version", props.Version)
 			d.Set("fqdn", props.FullyQualifiedDomainName)
+			d.Set("replication_role", props.ReplicationRole)
 
 			if network := props.Network; network != nil {
 				publicNetworkAccess := false
@@ -458,6 +545,22 @@ func resourcePostgresqlFlexibleServerRead(d *pluginsdk.ResourceData, meta interf
 			if props.AuthConfig != nil {
 				d.Set("authentication", flattenFlexibleServerAuthConfig(props.AuthConfig))
 			}
+
+			cmk, err := flattenFlexibleServerDataEncryption(props.DataEncryption)
+			if err != nil {
+				return fmt.Errorf("flattening `customer_managed_key`: %+v", err)
+			}
+			if err := d.Set("customer_managed_key", cmk); err != nil {
+				return fmt.Errorf("setting `customer_managed_key`: %+v", err)
+			}
+		}
+
+		identity, err := flattenFlexibleServerIdentity(model.Identity)
+		if err != nil {
+			return fmt.Errorf("flattening `identity`: %+v", err)
+		}
+		if err := d.Set("identity", identity); err != nil {
+			return fmt.Errorf("setting `identity`: %+v", err)
 		}
 
 		sku, err := flattenFlexibleServerSku(model.Sku)
@@ -483,6 +586,33 @@ func resourcePostgresqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta inte
 		return err
 	}
 
+	// promoting a replica to a stand-alone server has to be performed on its own, ahead of any other changes to the server
+	if d.HasChange("replication_role") {
+		oldReplicationRole, newReplicationRole := d.GetChange("replication_role")
+		isReplica := oldReplicationRole.(string) == string(servers.ReplicationRoleAsyncReplica) || oldReplicationRole.(string) == string(servers.ReplicationRoleGeoAsyncReplica)
+		if !isReplica || newReplicationRole.(string) != string(servers.ReplicationRoleNone) {
+			return fmt.Errorf("`replication_role` can only be updated from `AsyncReplica` or `GeoAsyncReplica` to `None`")
+		}
+
+		replicationRole := servers.ReplicationRoleNone
+		parameters := servers.ServerForUpdate{
+			Properties: &servers.ServerPropertiesForUpdate{
+				ReplicationRole: &replicationRole,
+			},
+		}
+
+		if err = client.UpdateThenPoll(ctx, *id, parameters); err != nil {
+			return fmt.Errorf("promoting %s to a stand-alone server: %+v", *id, err)
+		}
+	}
+
+	// a major version upgrade has to be performed on its own, ahead of any other changes to the server
+	if d.HasChange("version") {
+		if err := upgradePostgresqlFlexibleServerVersion(d, meta, *id); err != nil {
+			return err
+		}
+	}
+
 	parameters := servers.ServerForUpdate{
 		Properties: &servers.ServerPropertiesForUpdate{},
 	}
@@ -559,6 +689,18 @@ func resourcePostgresqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta inte
 		parameters.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
+	if d.HasChange("identity") {
+		identity, err := expandFlexibleServerIdentity(d.Get("identity").([]interface{}))
+		if err != nil {
+			return fmt.Errorf("expanding `identity` for %s: %+v", id, err)
+		}
+		parameters.Identity = identity
+	}
+
+	if d.HasChange("customer_managed_key") {
+		parameters.Properties.DataEncryption = expandFlexibleServerDataEncryption(d.Get("customer_managed_key").([]interface{}))
+	}
+
 	if d.HasChange("high_availability") {
 		parameters.Properties.HighAvailability = expandFlexibleServerHighAvailability(d.Get("high_availability").([]interface{}), false)
 	}
@@ -585,6 +727,87 @@ func resourcePostgresqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta inte
 	return resourcePostgresqlFlexibleServerRead(d, meta)
 }
 
+func upgradePostgresqlFlexibleServerVersion(d *pluginsdk.ResourceData, meta interface{}, id servers.FlexibleServerId) error {
+	client := meta.(*clients.Client).Postgres.FlexibleServersClient
+
+	// the duration of an in-place major version upgrade scales with the size of the data being upgraded, as such
+	// we wait for the larger of the configured `update` timeout and an estimate derived from the allocated storage
+	ctx, cancel := context.WithTimeout(meta.(*clients.Client).StopContext, postgresqlFlexibleServerVersionUpgradeTimeout(d.Timeout(pluginsdk.TimeoutUpdate), d.Get("storage_mb").(int)))
+	defer cancel()
+
+	oldVersion, newVersion := d.GetChange("version")
+	log.Printf("[DEBUG] Upgrading %s from version %q to %q", id, oldVersion.(string), newVersion.(string))
+
+	createMode := servers.CreateModeForUpdateUpdate
+	version := servers.ServerVersion(newVersion.(string))
+	parameters := servers.ServerForUpdate{
+		Properties: &servers.ServerPropertiesForUpdate{
+			CreateMode: &createMode,
+			Version:    &version,
+		},
+	}
+
+	if err := client.UpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("upgrading the major version of %s from %q to %q: %+v", id, oldVersion.(string), newVersion.(string), err)
+	}
+
+	return nil
+}
+
+// postgresqlFlexibleServerVersionUpgradeTimeout returns how long to wait for an in-place major version upgrade,
+// allowing an hour plus an additional hour for each started 512 GiB of allocated storage
+func postgresqlFlexibleServerVersionUpgradeTimeout(updateTimeout time.Duration, storageMb int) time.Duration {
+	estimate := 1*time.Hour + time.Duration((storageMb+524287)/524288)*time.Hour
+	if updateTimeout > estimate {
+		return updateTimeout
+	}
+	return estimate
+}
+
+// postgresqlFlexibleServerVersionUpgrades lists the major versions which each major version can be upgraded to in-place
+var postgresqlFlexibleServerVersionUpgrades = map[servers.ServerVersion][]servers.ServerVersion{
+	servers.ServerVersionOneOne:   {servers.ServerVersionOneTwo, servers.ServerVersionOneThree, servers.ServerVersionOneFour},
+	servers.ServerVersionOneTwo:   {servers.ServerVersionOneThree, servers.ServerVersionOneFour},
+	servers.ServerVersionOneThree: {servers.ServerVersionOneFour},
+	servers.ServerVersionOneFour:  {},
+}
+
+func resourcePostgresqlFlexibleServerVersionUpgradeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	oldVal, newVal := d.GetChange("version")
+	oldVersion := oldVal.(string)
+	newVersion := newVal.(string)
+
+	// nothing to validate when the server is being created or the version is left unchanged/unknown
+	if d.Id() == "" || oldVersion == "" || newVersion == "" || oldVersion == newVersion {
+		return nil
+	}
+
+	oldMajor, err := strconv.Atoi(oldVersion)
+	if err != nil {
+		return fmt.Errorf("parsing the existing `version` %q: %+v", oldVersion, err)
+	}
+	newMajor, err := strconv.Atoi(newVersion)
+	if err != nil {
+		return fmt.Errorf("parsing `version` %q: %+v", newVersion, err)
+	}
+
+	if newMajor < oldMajor {
+		return fmt.Errorf("`version` cannot be downgraded from %q to %q - a new server has to be provisioned to use an earlier major version", oldVersion, newVersion)
+	}
+
+	supported, ok := postgresqlFlexibleServerVersionUpgrades[servers.ServerVersion(oldVersion)]
+	if !ok {
+		return fmt.Errorf("an in-place major version upgrade is not supported from `version` %q", oldVersion)
+	}
+	for _, v := range supported {
+		if string(v) == newVersion {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("an in-place major version upgrade from `version` %q to %q is not supported", oldVersion, newVersion)
+}
+
 func resourcePostgresqlFlexibleServerDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Postgres.FlexibleServersClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
@@ -780,9 +1003,19 @@ func expandFlexibleServerAuthConfig(authRaw []interface{}) *servers.AuthConfig {
 	}
 
 	authConfigs := authRaw[0].(map[string]interface{})
+	activeDirectoryAuthEnabled := servers.ActiveDirectoryAuthEnumDisabled
+	if authConfigs["active_directory_auth_enabled"].(bool) {
+		activeDirectoryAuthEnabled = servers.ActiveDirectoryAuthEnumEnabled
+	}
+
+	passwordAuthEnabled := servers.PasswordAuthEnumDisabled
+	if authConfigs["password_auth_enabled"].(bool) {
+		passwordAuthEnabled = servers.PasswordAuthEnumEnabled
+	}
+
 	out := servers.AuthConfig{
-		ActiveDirectoryAuthEnabled: utils.Bool(authConfigs["active_directory_auth_enabled"].(bool)),
-		PasswordAuthEnabled:        utils.Bool(authConfigs["password_auth_enabled"].(bool)),
+		ActiveDirectoryAuth: &activeDirectoryAuthEnabled,
+		PasswordAuth:        &passwordAuthEnabled,
 	}
 	if tenantId, ok := authConfigs["tenant_id"].(string); ok {
 		out.TenantId = &tenantId
@@ -799,15 +1032,15 @@ func flattenFlexibleServerAuthConfig(ac *servers.AuthConfig) interface{} {
 	}
 
 	aadEnabled := false
-	if ac.ActiveDirectoryAuthEnabled != nil {
-		aadEnabled = *ac.ActiveDirectoryAuthEnabled
+	if ac.ActiveDirectoryAuth != nil {
+		aadEnabled = *ac.ActiveDirectoryAuth == servers.ActiveDirectoryAuthEnumEnabled
 	}
 	out["active_directory_auth_enabled"] = aadEnabled
 
 	// It is by design if PasswordAuthEnabled is not returned or undefined, we consider it as true.
 	pwdEnabled := true
-	if ac.PasswordAuthEnabled != nil {
-		pwdEnabled = *ac.PasswordAuthEnabled
+	if ac.PasswordAuth != nil {
+		pwdEnabled = *ac.PasswordAuth == servers.PasswordAuthEnumEnabled
 	}
 	out["password_auth_enabled"] = pwdEnabled
 
@@ -819,3 +1052,96 @@ func flattenFlexibleServerAuthConfig(ac *servers.AuthConfig) interface{} {
 	result = append(result, out)
 	return result
 }
+
+func expandFlexibleServerDataEncryption(input []interface{}) *servers.DataEncryption {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+	v := input[0].(map[string]interface{})
+
+	keyType := servers.ArmServerKeyTypeAzureKeyVault
+	dataEncryption := servers.DataEncryption{
+		Type:                          &keyType,
+		PrimaryKeyURI:                 utils.String(v["key_vault_key_id"].(string)),
+		PrimaryUserAssignedIdentityId: utils.String(v["primary_user_assigned_identity_id"].(string)),
+	}
+
+	if geoBackupKeyId := v["geo_backup_key_vault_key_id"].(string); geoBackupKeyId != "" {
+		dataEncryption.GeoBackupKeyURI = utils.String(geoBackupKeyId)
+	}
+
+	if geoBackupIdentityId := v["geo_backup_user_assigned_identity_id"].(string); geoBackupIdentityId != "" {
+		dataEncryption.GeoBackupUserAssignedIdentityId = utils.String(geoBackupIdentityId)
+	}
+
+	return &dataEncryption
+}
+
+func flattenFlexibleServerDataEncryption(de *servers.DataEncryption) ([]interface{}, error) {
+	if de == nil || de.Type == nil || *de.Type != servers.ArmServerKeyTypeAzureKeyVault {
+		return []interface{}{}, nil
+	}
+
+	item := map[string]interface{}{}
+	if de.PrimaryKeyURI != nil {
+		item["key_vault_key_id"] = *de.PrimaryKeyURI
+	}
+	if identity := de.PrimaryUserAssignedIdentityId; identity != nil {
+		parsed, err := commonids.ParseUserAssignedIdentityIDInsensitively(*identity)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %q: %+v", *identity, err)
+		}
+		item["primary_user_assigned_identity_id"] = parsed.ID()
+	}
+
+	if de.GeoBackupKeyURI != nil {
+		item["geo_backup_key_vault_key_id"] = *de.GeoBackupKeyURI
+	}
+	if identity := de.GeoBackupUserAssignedIdentityId; identity != nil && *identity != "" {
+		parsed, err := commonids.ParseUserAssignedIdentityIDInsensitively(*identity)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %q: %+v", *identity, err)
+		}
+		item["geo_backup_user_assigned_identity_id"] = parsed.ID()
+	}
+
+	return []interface{}{item}, nil
+}
+
+func expandFlexibleServerIdentity(input []interface{}) (*servers.UserAssignedIdentity, error) {
+	expanded, err := identity.ExpandUserAssignedMap(input)
+	if err != nil {
+		return nil, err
+	}
+
+	out := servers.UserAssignedIdentity{
+		Type: servers.IdentityType(string(expanded.Type)),
+	}
+	if expanded.Type == identity.TypeUserAssigned {
+		ids := make(map[string]servers.UserIdentity)
+		for k := range expanded.IdentityIds {
+			ids[k] = servers.UserIdentity{}
+		}
+		out.UserAssignedIdentities = &ids
+	}
+
+	return &out, nil
+}
+
+func flattenFlexibleServerIdentity(input *servers.UserAssignedIdentity) (*[]interface{}, error) {
+	var transform *identity.UserAssignedMap
+
+	if input != nil {
+		transform = &identity.UserAssignedMap{
+			Type:        identity.Type(string(input.Type)),
+			IdentityIds: make(map[string]identity.UserAssignedIdentityDetails),
+		}
+		if input.UserAssignedIdentities != nil {
+			for k := range *input.UserAssignedIdentities {
+				transform.IdentityIds[k] = identity.UserAssignedIdentityDetails{}
+			}
+		}
+	}
+
+	return identity.FlattenUserAssignedMap(transform)
+}
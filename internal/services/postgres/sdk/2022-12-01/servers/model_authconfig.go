@@ -0,0 +1,10 @@
+package servers
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type AuthConfig struct {
+	ActiveDirectoryAuth *ActiveDirectoryAuthEnum `json:"activeDirectoryAuth,omitempty"`
+	PasswordAuth        *PasswordAuthEnum        `json:"passwordAuth,omitempty"`
+	TenantId            *string                  `json:"tenantId,omitempty"`
+}
@@ -51,9 +51,11 @@ func NewClient(options *common.ClientOptions) *Client {
 
 	fileSystemsClient := filesystems.NewWithEnvironment(options.Environment)
 	options.ConfigureClient(&fileSystemsClient.Client, options.StorageAuthorizer)
+	configureDataPlaneEndpoint(&fileSystemsClient.Client, options.Environment.StorageEndpointSuffix)
 
 	adlsGen2PathsClient := paths.NewWithEnvironment(options.Environment)
 	options.ConfigureClient(&adlsGen2PathsClient.Client, options.StorageAuthorizer)
+	configureDataPlaneEndpoint(&adlsGen2PathsClient.Client, options.Environment.StorageEndpointSuffix)
 
 	managementPoliciesClient := storage.NewManagementPoliciesClientWithBaseURI(options.ResourceManagerEndpoint, options.SubscriptionId)
 	options.ConfigureClient(&managementPoliciesClient.Client, options.ResourceManagerAuthorizer)
@@ -116,6 +118,7 @@ func (client Client) AccountsDataPlaneClient(ctx context.Context, account accoun
 	if client.storageAdAuth != nil {
 		accountsClient := accounts.NewWithEnvironment(client.Environment)
 		accountsClient.Client.Authorizer = *client.storageAdAuth
+		configureDataPlaneEndpoint(&accountsClient.Client, client.Environment.StorageEndpointSuffix)
 		return &accountsClient, nil
 	}
 
@@ -131,6 +134,7 @@ func (client Client) AccountsDataPlaneClient(ctx context.Context, account accoun
 
 	accountsClient := accounts.NewWithEnvironment(client.Environment)
 	accountsClient.Client.Authorizer = storageAuth
+	configureDataPlaneEndpoint(&accountsClient.Client, client.Environment.StorageEndpointSuffix)
 	return &accountsClient, nil
 }
 
@@ -138,6 +142,7 @@ func (client Client) BlobsClient(ctx context.Context, account accountDetails) (*
 	if client.storageAdAuth != nil {
 		blobsClient := blobs.NewWithEnvironment(client.Environment)
 		blobsClient.Client.Authorizer = *client.storageAdAuth
+		configureDataPlaneEndpoint(&blobsClient.Client, client.Environment.StorageEndpointSuffix)
 		return &blobsClient, nil
 	}
 
@@ -153,15 +158,26 @@ func (client Client) BlobsClient(ctx context.Context, account accountDetails) (*
 
 	blobsClient := blobs.NewWithEnvironment(client.Environment)
 	blobsClient.Client.Authorizer = storageAuth
+	configureDataPlaneEndpoint(&blobsClient.Client, client.Environment.StorageEndpointSuffix)
 	return &blobsClient, nil
 }
 
 func (client Client) ContainersClient(ctx context.Context, account accountDetails) (shim.StorageContainerWrapper, error) {
+	containersClient, err := client.ContainersDataPlaneClient(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	shim := shim.NewDataPlaneStorageContainerWrapper(containersClient)
+	return shim, nil
+}
+
+func (client Client) ContainersDataPlaneClient(ctx context.Context, account accountDetails) (*containers.Client, error) {
 	if client.storageAdAuth != nil {
 		containersClient := containers.NewWithEnvironment(client.Environment)
 		containersClient.Client.Authorizer = *client.storageAdAuth
-		shim := shim.NewDataPlaneStorageContainerWrapper(&containersClient)
-		return shim, nil
+		configureDataPlaneEndpoint(&containersClient.Client, client.Environment.StorageEndpointSuffix)
+		return &containersClient, nil
 	}
 
 	accountKey, err := account.AccountKey(ctx, client)
@@ -176,9 +192,8 @@ func (client Client) ContainersClient(ctx context.Context, account accountDetail
 
 	containersClient := containers.NewWithEnvironment(client.Environment)
 	containersClient.Client.Authorizer = storageAuth
-
-	shim := shim.NewDataPlaneStorageContainerWrapper(&containersClient)
-	return shim, nil
+	configureDataPlaneEndpoint(&containersClient.Client, client.Environment.StorageEndpointSuffix)
+	return &containersClient, nil
 }
 
 func (client Client) FileShareDirectoriesClient(ctx context.Context, account accountDetails) (*directories.Client, error) {
@@ -196,6 +211,7 @@ func (client Client) FileShareDirectoriesClient(ctx context.Context, account acc
 
 	directoriesClient := directories.NewWithEnvironment(client.Environment)
 	directoriesClient.Client.Authorizer = storageAuth
+	configureDataPlaneEndpoint(&directoriesClient.Client, client.Environment.StorageEndpointSuffix)
 	return &directoriesClient, nil
 }
 
@@ -214,6 +230,7 @@ func (client Client) FileShareFilesClient(ctx context.Context, account accountDe
 
 	filesClient := files.NewWithEnvironment(client.Environment)
 	filesClient.Client.Authorizer = storageAuth
+	configureDataPlaneEndpoint(&filesClient.Client, client.Environment.StorageEndpointSuffix)
 	return &filesClient, nil
 }
 
@@ -232,6 +249,7 @@ func (client Client) FileSharesClient(ctx context.Context, account accountDetail
 
 	sharesClient := shares.NewWithEnvironment(client.Environment)
 	sharesClient.Client.Authorizer = storageAuth
+	configureDataPlaneEndpoint(&sharesClient.Client, client.Environment.StorageEndpointSuffix)
 	shim := shim.NewDataPlaneStorageShareWrapper(&sharesClient)
 	return shim, nil
 }
@@ -240,6 +258,7 @@ func (client Client) QueuesClient(ctx context.Context, account accountDetails) (
 	if client.storageAdAuth != nil {
 		queueClient := queues.NewWithEnvironment(client.Environment)
 		queueClient.Client.Authorizer = *client.storageAdAuth
+		configureDataPlaneEndpoint(&queueClient.Client, client.Environment.StorageEndpointSuffix)
 		return shim.NewDataPlaneStorageQueueWrapper(&queueClient), nil
 	}
 
@@ -255,6 +274,7 @@ func (client Client) QueuesClient(ctx context.Context, account accountDetails) (
 
 	queuesClient := queues.NewWithEnvironment(client.Environment)
 	queuesClient.Client.Authorizer = storageAuth
+	configureDataPlaneEndpoint(&queuesClient.Client, client.Environment.StorageEndpointSuffix)
 	return shim.NewDataPlaneStorageQueueWrapper(&queuesClient), nil
 }
 
@@ -273,6 +293,7 @@ func (client Client) TableEntityClient(ctx context.Context, account accountDetai
 
 	entitiesClient := entities.NewWithEnvironment(client.Environment)
 	entitiesClient.Client.Authorizer = storageAuth
+	configureDataPlaneEndpoint(&entitiesClient.Client, client.Environment.StorageEndpointSuffix)
 	return &entitiesClient, nil
 }
 
@@ -291,6 +312,7 @@ func (client Client) TablesClient(ctx context.Context, account accountDetails) (
 
 	tablesClient := tables.NewWithEnvironment(client.Environment)
 	tablesClient.Client.Authorizer = storageAuth
+	configureDataPlaneEndpoint(&tablesClient.Client, client.Environment.StorageEndpointSuffix)
 	shim := shim.NewDataPlaneStorageTableWrapper(&tablesClient)
 	return shim, nil
 }
@@ -4,9 +4,13 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
+	"net/url"
+	"strings"
 	"sync"
 
 	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-09-01/storage" // nolint: staticcheck
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
 )
 
@@ -133,3 +137,71 @@ func populateAccountDetails(accountName string, props storage.Account) (*account
 		Properties:    props.AccountProperties,
 	}, nil
 }
+
+// configureDataPlaneEndpoint sends Data Plane requests to the endpoints returned by the API for the Storage Account.
+// The Data Plane clients build the host from the Account Name (for example `{name}.blob.core.windows.net`) - which
+// doesn't hold for Storage Accounts using the `AzureDnsZone` endpoint type, where the host also contains the DNS
+// Zone (for example `{name}.z01.blob.storage.azure.net`).
+func configureDataPlaneEndpoint(c *autorest.Client, endpointSuffix string) {
+	existing := c.RequestInspector
+	c.RequestInspector = func(p autorest.Preparer) autorest.Preparer {
+		if existing != nil {
+			p = existing(p)
+		}
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := p.Prepare(r)
+			if err != nil || r.URL == nil {
+				return r, err
+			}
+
+			if host := findDataPlaneEndpointHost(r.URL.Host, endpointSuffix); host != nil {
+				r.URL.Host = *host
+				r.Host = *host
+			}
+
+			return r, nil
+		})
+	}
+}
+
+func findDataPlaneEndpointHost(host, endpointSuffix string) *string {
+	segments := strings.SplitN(strings.ToLower(host), ".", 3)
+	if len(segments) != 3 || segments[2] != strings.ToLower(endpointSuffix) {
+		return nil
+	}
+	accountName := segments[0]
+	service := segments[1]
+
+	accountsLock.RLock()
+	account, ok := storageAccountsCache[accountName]
+	accountsLock.RUnlock()
+	if !ok || account.Properties == nil || account.Properties.DNSEndpointType != storage.DNSEndpointTypeAzureDNSZone || account.Properties.PrimaryEndpoints == nil {
+		return nil
+	}
+
+	endpoints := account.Properties.PrimaryEndpoints
+	var endpoint *string
+	switch service {
+	case "blob":
+		endpoint = endpoints.Blob
+	case "dfs":
+		endpoint = endpoints.Dfs
+	case "file":
+		endpoint = endpoints.File
+	case "queue":
+		endpoint = endpoints.Queue
+	case "table":
+		endpoint = endpoints.Table
+	}
+	if endpoint == nil {
+		return nil
+	}
+
+	u, err := url.Parse(*endpoint)
+	if err != nil || u.Host == "" {
+		log.Printf("[DEBUG] unable to parse the %s endpoint %q for Storage Account %q: %+v", service, *endpoint, accountName, err)
+		return nil
+	}
+
+	return &u.Host
+}
@@ -2,6 +2,7 @@ package storage
 
 import (
 	"fmt"
+	"log"
 	"net/http"
 	"strings"
 	"time"
@@ -99,6 +100,34 @@ func dataSourceStorageAccount() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"dns_endpoint_type": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"geo_replication_stats": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"status": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"last_sync_time": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"can_failover": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"primary_location": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -348,6 +377,20 @@ func dataSourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) e
 
 	d.SetId(id.ID())
 
+	geoReplicationStats := make([]interface{}, 0)
+	if resp.Sku != nil && storageAccountIsGeoRedundant(resp.Sku.Name) {
+		statsResp, err := client.GetProperties(ctx, id.ResourceGroup, id.Name, storage.AccountExpandGeoReplicationStats)
+		if err != nil {
+			// the geo-replication stats can be unavailable (e.g. whilst a failover is in progress) - which shouldn't fail the refresh
+			log.Printf("[DEBUG] Unable to retrieve the geo-replication stats for %s: %+v", id, err)
+		} else if props := statsResp.AccountProperties; props != nil {
+			geoReplicationStats = flattenStorageAccountGeoReplicationStats(props.GeoReplicationStats)
+		}
+	}
+	if err := d.Set("geo_replication_stats", geoReplicationStats); err != nil {
+		return fmt.Errorf("setting `geo_replication_stats`: %+v", err)
+	}
+
 	// handle the user not having permissions to list the keys
 	d.Set("primary_connection_string", "")
 	d.Set("secondary_connection_string", "")
@@ -389,6 +432,12 @@ func dataSourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) e
 		d.Set("nfsv3_enabled", props.EnableNfsV3)
 		d.Set("allow_nested_items_to_be_public", props.AllowBlobPublicAccess)
 
+		dnsEndpointType := storage.DNSEndpointTypeStandard
+		if props.DNSEndpointType != "" {
+			dnsEndpointType = props.DNSEndpointType
+		}
+		d.Set("dns_endpoint_type", string(dnsEndpointType))
+
 		if customDomain := props.CustomDomain; customDomain != nil {
 			if err := d.Set("custom_domain", flattenStorageAccountCustomDomain(customDomain)); err != nil {
 				return fmt.Errorf("setting `custom_domain`: %+v", err)
@@ -402,12 +451,12 @@ func dataSourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) e
 		if accessKeys := keys.Keys; accessKeys != nil {
 			storageAccessKeys := *accessKeys
 			if len(storageAccessKeys) > 0 {
-				pcs := fmt.Sprintf("DefaultEndpointsProtocol=https;AccountName=%s;AccountKey=%s;EndpointSuffix=%s", *resp.Name, *storageAccessKeys[0].Value, endpointSuffix)
+				pcs := getStorageAccountConnectionString(*resp.Name, *storageAccessKeys[0].Value, endpointSuffix, props.DNSEndpointType, props.PrimaryEndpoints)
 				d.Set("primary_connection_string", pcs)
 			}
 
 			if len(storageAccessKeys) > 1 {
-				scs := fmt.Sprintf("DefaultEndpointsProtocol=https;AccountName=%s;AccountKey=%s;EndpointSuffix=%s", *resp.Name, *storageAccessKeys[1].Value, endpointSuffix)
+				scs := getStorageAccountConnectionString(*resp.Name, *storageAccessKeys[1].Value, endpointSuffix, props.DNSEndpointType, props.PrimaryEndpoints)
 				d.Set("secondary_connection_string", scs)
 			}
 		}
@@ -274,6 +274,17 @@ func resourceStorageAccount() *pluginsdk.Resource {
 
 			"edge_zone": commonschema.EdgeZoneOptionalForceNew(),
 
+			"dns_endpoint_type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(storage.DNSEndpointTypeStandard),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(storage.DNSEndpointTypeStandard),
+					string(storage.DNSEndpointTypeAzureDNSZone),
+				}, false),
+			},
+
 			// TODO 4.0: change this from enable_* to *_enabled
 			"enable_https_traffic_only": {
 				Type:     pluginsdk.TypeBool,
@@ -843,6 +854,41 @@ func resourceStorageAccount() *pluginsdk.Resource {
 				Default:  false,
 			},
 
+			"local_user_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"failover_trigger": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"geo_replication_stats": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"status": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"last_sync_time": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"can_failover": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"large_file_share_enabled": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
@@ -1037,6 +1083,11 @@ func resourceStorageAccount() *pluginsdk.Resource {
 					}
 				}
 
+				// a failover can only be performed on an existing Storage Account
+				if d.Id() == "" && d.Get("failover_trigger").(string) != "" {
+					return fmt.Errorf("`failover_trigger` cannot be set when creating a Storage Account")
+				}
+
 				if d.HasChange("large_file_share_enabled") {
 					lfsEnabled, changedEnabled := d.GetChange("large_file_share_enabled")
 					if lfsEnabled.(bool) && !changedEnabled.(bool) {
@@ -1104,6 +1155,7 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 	crossTenantReplication := d.Get("cross_tenant_replication_enabled").(bool)
 	publicNetworkAccess := storage.PublicNetworkAccessDisabled
 	isSftpEnabled := d.Get("sftp_enabled").(bool)
+	isLocalUserEnabled := d.Get("local_user_enabled").(bool)
 	if d.Get("public_network_access_enabled").(bool) {
 		publicNetworkAccess = storage.PublicNetworkAccessEnabled
 	}
@@ -1131,6 +1183,8 @@ func resourceStorageAccountCreate(d *pluginsdk.ResourceData, meta interface{}) e
 			AllowCrossTenantReplication:  &crossTenantReplication,
 			SasPolicy:                    expandStorageAccountSASPolicy(d.Get("sas_policy").([]interface{})),
 			IsSftpEnabled:                &isSftpEnabled,
+			IsLocalUserEnabled:           &isLocalUserEnabled,
+			DNSEndpointType:              storage.DNSEndpointType(d.Get("dns_endpoint_type").(string)),
 		},
 	}
 
@@ -1619,6 +1673,20 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
+	if d.HasChange("local_user_enabled") {
+		localUserEnabled := d.Get("local_user_enabled").(bool)
+
+		opts := storage.AccountUpdateParameters{
+			AccountPropertiesUpdateParameters: &storage.AccountPropertiesUpdateParameters{
+				IsLocalUserEnabled: &localUserEnabled,
+			},
+		}
+
+		if _, err := client.Update(ctx, id.ResourceGroup, id.Name, opts); err != nil {
+			return fmt.Errorf("updating `local_user_enabled` for %s: %+v", *id, err)
+		}
+	}
+
 	if d.HasChange("enable_https_traffic_only") {
 		enableHTTPSTrafficOnly := d.Get("enable_https_traffic_only").(bool)
 
@@ -1913,6 +1981,33 @@ func resourceStorageAccountUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
+	// the failover is performed last, since it converts the Storage Account to locally-redundant storage
+	if d.HasChange("failover_trigger") && d.Get("failover_trigger").(string) != "" {
+		log.Printf("[DEBUG] Failing over %s to the secondary location..", *id)
+		future, err := client.Failover(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			return fmt.Errorf("failing over %s: %+v", *id, err)
+		}
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for the failover of %s: %+v", *id, err)
+		}
+
+		// the Storage Account is now locally-redundant in the new primary location, so the configured replication
+		// type is re-applied to re-enable geo-redundancy rather than leaving a diff on `account_replication_type`
+		log.Printf("[DEBUG] Re-enabling geo-redundancy for %s..", *id)
+		opts := storage.AccountUpdateParameters{
+			Sku: &storage.Sku{
+				Name: storage.SkuName(storageType),
+			},
+		}
+		if _, err := client.Update(ctx, id.ResourceGroup, id.Name, opts); err != nil {
+			return fmt.Errorf("updating the replication type of %s following the failover: %+v", *id, err)
+		}
+
+		// the Storage Account has moved location, so the cached account details are stale
+		meta.(*clients.Client).Storage.RemoveAccountFromCache(id.Name)
+	}
+
 	return resourceStorageAccountRead(d, meta)
 }
 
@@ -1936,6 +2031,21 @@ func resourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 		return fmt.Errorf("reading the state of AzureRM Storage Account %q: %+v", id.Name, err)
 	}
 
+	geoReplicationStats := make([]interface{}, 0)
+	if resp.Sku != nil && storageAccountIsGeoRedundant(resp.Sku.Name) {
+		// the geo-replication stats are only available for geo-redundant Storage Accounts
+		statsResp, err := client.GetProperties(ctx, id.ResourceGroup, id.Name, storage.AccountExpandGeoReplicationStats)
+		if err != nil {
+			// the geo-replication stats can be unavailable (e.g. whilst a failover is in progress) - which shouldn't fail the refresh
+			log.Printf("[DEBUG] Unable to retrieve the geo-replication stats for %s: %+v", *id, err)
+		} else if props := statsResp.AccountProperties; props != nil {
+			geoReplicationStats = flattenStorageAccountGeoReplicationStats(props.GeoReplicationStats)
+		}
+	}
+	if err := d.Set("geo_replication_stats", geoReplicationStats); err != nil {
+		return fmt.Errorf("setting `geo_replication_stats`: %+v", err)
+	}
+
 	// handle the user not having permissions to list the keys
 	d.Set("primary_connection_string", "")
 	d.Set("secondary_connection_string", "")
@@ -2040,12 +2150,12 @@ func resourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 		if accessKeys := keys.Keys; accessKeys != nil {
 			storageAccountKeys := *accessKeys
 			if len(storageAccountKeys) > 0 {
-				pcs := fmt.Sprintf("DefaultEndpointsProtocol=https;AccountName=%s;AccountKey=%s;EndpointSuffix=%s", *resp.Name, *storageAccountKeys[0].Value, endpointSuffix)
+				pcs := getStorageAccountConnectionString(*resp.Name, *storageAccountKeys[0].Value, endpointSuffix, props.DNSEndpointType, props.PrimaryEndpoints)
 				d.Set("primary_connection_string", pcs)
 			}
 
 			if len(storageAccountKeys) > 1 {
-				scs := fmt.Sprintf("DefaultEndpointsProtocol=https;AccountName=%s;AccountKey=%s;EndpointSuffix=%s", *resp.Name, *storageAccountKeys[1].Value, endpointSuffix)
+				scs := getStorageAccountConnectionString(*resp.Name, *storageAccountKeys[1].Value, endpointSuffix, props.DNSEndpointType, props.PrimaryEndpoints)
 				d.Set("secondary_connection_string", scs)
 			}
 		}
@@ -2134,6 +2244,19 @@ func resourceStorageAccountRead(d *pluginsdk.ResourceData, meta interface{}) err
 
 		d.Set("allowed_copy_scope", props.AllowedCopyScope)
 		d.Set("sftp_enabled", props.IsSftpEnabled)
+
+		// local users are enabled by default, so the API may omit this field
+		localUserEnabled := true
+		if props.IsLocalUserEnabled != nil {
+			localUserEnabled = *props.IsLocalUserEnabled
+		}
+		d.Set("local_user_enabled", localUserEnabled)
+
+		dnsEndpointType := storage.DNSEndpointTypeStandard
+		if props.DNSEndpointType != "" {
+			dnsEndpointType = props.DNSEndpointType
+		}
+		d.Set("dns_endpoint_type", string(dnsEndpointType))
 	}
 
 	if accessKeys := keys.Keys; accessKeys != nil {
@@ -3563,6 +3686,31 @@ func getBlobConnectionString(blobEndpoint *string, acctName *string, acctKey *st
 	return fmt.Sprintf("DefaultEndpointsProtocol=https;BlobEndpoint=%s;AccountName=%s;AccountKey=%s", endpoint, name, key)
 }
 
+// getStorageAccountConnectionString builds the connection string for the Storage Account - accounts using the
+// `AzureDnsZone` endpoint type are hosted within a DNS Zone, so the endpoints can't be derived from the suffix
+func getStorageAccountConnectionString(accountName string, accountKey string, endpointSuffix string, dnsEndpointType storage.DNSEndpointType, endpoints *storage.Endpoints) string {
+	if dnsEndpointType != storage.DNSEndpointTypeAzureDNSZone || endpoints == nil {
+		return fmt.Sprintf("DefaultEndpointsProtocol=https;AccountName=%s;AccountKey=%s;EndpointSuffix=%s", accountName, accountKey, endpointSuffix)
+	}
+
+	connectionString := fmt.Sprintf("DefaultEndpointsProtocol=https;AccountName=%s;AccountKey=%s", accountName, accountKey)
+	for _, v := range []struct {
+		name     string
+		endpoint *string
+	}{
+		{name: "BlobEndpoint", endpoint: endpoints.Blob},
+		{name: "FileEndpoint", endpoint: endpoints.File},
+		{name: "QueueEndpoint", endpoint: endpoints.Queue},
+		{name: "TableEndpoint", endpoint: endpoints.Table},
+	} {
+		if v.endpoint != nil && *v.endpoint != "" {
+			connectionString = fmt.Sprintf("%s;%s=%s", connectionString, v.name, *v.endpoint)
+		}
+	}
+
+	return connectionString
+}
+
 func flattenAndSetAzureRmStorageAccountPrimaryEndpoints(d *pluginsdk.ResourceData, primary *storage.Endpoints) error {
 	if primary == nil {
 		return fmt.Errorf("primary endpoints should not be empty")
@@ -3635,6 +3783,38 @@ func setEndpointAndHost(d *pluginsdk.ResourceData, ordinalString string, endpoin
 	return nil
 }
 
+func storageAccountIsGeoRedundant(input storage.SkuName) bool {
+	switch input {
+	case storage.SkuNameStandardGRS, storage.SkuNameStandardRAGRS, storage.SkuNameStandardGZRS, storage.SkuNameStandardRAGZRS:
+		return true
+	}
+	return false
+}
+
+func flattenStorageAccountGeoReplicationStats(input *storage.GeoReplicationStats) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	lastSyncTime := ""
+	if input.LastSyncTime != nil {
+		lastSyncTime = input.LastSyncTime.Format(time.RFC3339)
+	}
+
+	canFailover := false
+	if input.CanFailover != nil {
+		canFailover = *input.CanFailover
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"status":         string(input.Status),
+			"last_sync_time": lastSyncTime,
+			"can_failover":   canFailover,
+		},
+	}
+}
+
 func expandEdgeZone(input string) *storage.ExtendedLocation {
 	normalized := edgezones.Normalize(input)
 	if normalized == "" {
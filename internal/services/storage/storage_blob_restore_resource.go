@@ -0,0 +1,257 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-09-01/storage" // nolint: staticcheck
+	"github.com/Azure/go-autorest/autorest/date"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceStorageBlobRestore() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStorageBlobRestoreCreate,
+		Read:   resourceStorageBlobRestoreRead,
+		Delete: resourceStorageBlobRestoreDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
+			_, err := parse.BlobRestoreID(id)
+			return err
+		}, importStorageBlobRestore),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"storage_account_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.StorageAccountID,
+			},
+
+			"time_to_restore": {
+				Type:             pluginsdk.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppress.RFC3339Time,
+			},
+
+			"blob_range": {
+				Type:     pluginsdk.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 10,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"start_range": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"end_range": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"status": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"failure_reason": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceStorageBlobRestoreCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.AccountsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	storageAccountId, err := parse.StorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(storageAccountId.Name, storageAccountResourceName)
+	defer locks.UnlockByName(storageAccountId.Name, storageAccountResourceName)
+
+	timeToRestore, err := time.Parse(time.RFC3339, d.Get("time_to_restore").(string))
+	if err != nil {
+		return fmt.Errorf("parsing `time_to_restore`: %+v", err)
+	}
+
+	parameters := storage.BlobRestoreParameters{
+		TimeToRestore: &date.Time{Time: timeToRestore},
+		BlobRanges:    expandStorageBlobRestoreRanges(d.Get("blob_range").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Restoring the Blob Ranges for %s to %s..", *storageAccountId, timeToRestore.Format(time.RFC3339))
+	future, err := client.RestoreBlobRanges(ctx, storageAccountId.ResourceGroup, storageAccountId.Name, parameters)
+	if err != nil {
+		return fmt.Errorf("restoring the Blob Ranges for %s: %+v", *storageAccountId, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting for the restore of the Blob Ranges for %s: %+v", *storageAccountId, err)
+	}
+
+	result, err := future.Result(*client)
+	if err != nil {
+		return fmt.Errorf("retrieving the result of the restore of the Blob Ranges for %s: %+v", *storageAccountId, err)
+	}
+	if result.RestoreID == nil || *result.RestoreID == "" {
+		return fmt.Errorf("restoring the Blob Ranges for %s: `restoreId` was nil", *storageAccountId)
+	}
+	if result.Status == storage.BlobRestoreProgressStatusFailed {
+		return fmt.Errorf("restoring the Blob Ranges for %s failed: %s", *storageAccountId, utils.NormalizeNilableString(result.FailureReason))
+	}
+
+	id := parse.NewBlobRestoreID(storageAccountId.SubscriptionId, storageAccountId.ResourceGroup, storageAccountId.Name, *result.RestoreID)
+	d.SetId(id.ID())
+
+	return resourceStorageBlobRestoreRead(d, meta)
+}
+
+func resourceStorageBlobRestoreRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.AccountsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.BlobRestoreID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	storageAccountId := parse.NewStorageAccountID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName)
+
+	resp, err := client.GetProperties(ctx, id.ResourceGroup, id.StorageAccountName, storage.AccountExpandBlobRestoreStatus)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] %s was not found - removing %s from state", storageAccountId, *id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", storageAccountId, err)
+	}
+
+	d.Set("storage_account_id", storageAccountId.ID())
+
+	// the API only returns the status of the most recent restore - so once another restore has been
+	// performed against this Storage Account the values for this restore are retained from the state
+	if props := resp.AccountProperties; props != nil && props.BlobRestoreStatus != nil {
+		status := props.BlobRestoreStatus
+		if status.RestoreID != nil && *status.RestoreID == id.Name {
+			d.Set("status", string(status.Status))
+			d.Set("failure_reason", utils.NormalizeNilableString(status.FailureReason))
+
+			// `time_to_restore` is retained from the state (or set when importing), since the API returns this in a
+			// different format
+			if params := status.Parameters; params != nil {
+				if err := d.Set("blob_range", flattenStorageBlobRestoreRanges(params.BlobRanges)); err != nil {
+					return fmt.Errorf("setting `blob_range`: %+v", err)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceStorageBlobRestoreDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	id, err := parse.BlobRestoreID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// a restore can't be undone, so this only removes it from the state
+	log.Printf("[DEBUG] %s can't be deleted - removing from state", *id)
+	return nil
+}
+
+func importStorageBlobRestore(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
+	client := meta.(*clients.Client).Storage.AccountsClient
+
+	id, err := parse.BlobRestoreID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	storageAccountId := parse.NewStorageAccountID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName)
+
+	resp, err := client.GetProperties(ctx, id.ResourceGroup, id.StorageAccountName, storage.AccountExpandBlobRestoreStatus)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", storageAccountId, err)
+	}
+
+	// the API only returns the most recent restore, so the details of any earlier restore can't be retrieved
+	if resp.AccountProperties == nil || resp.AccountProperties.BlobRestoreStatus == nil || resp.AccountProperties.BlobRestoreStatus.RestoreID == nil || *resp.AccountProperties.BlobRestoreStatus.RestoreID != id.Name {
+		return nil, fmt.Errorf("only the most recent restore for %s can be imported", storageAccountId)
+	}
+
+	if params := resp.AccountProperties.BlobRestoreStatus.Parameters; params != nil && params.TimeToRestore != nil {
+		d.Set("time_to_restore", params.TimeToRestore.UTC().Format(time.RFC3339))
+	}
+
+	return []*pluginsdk.ResourceData{d}, nil
+}
+
+func expandStorageBlobRestoreRanges(input []interface{}) *[]storage.BlobRestoreRange {
+	results := make([]storage.BlobRestoreRange, 0)
+	for _, item := range input {
+		// both ranges are optional, where an empty range means the start/end of the Storage Account
+		blobRange := storage.BlobRestoreRange{
+			StartRange: utils.String(""),
+			EndRange:   utils.String(""),
+		}
+		if v, ok := item.(map[string]interface{}); ok {
+			blobRange.StartRange = utils.String(v["start_range"].(string))
+			blobRange.EndRange = utils.String(v["end_range"].(string))
+		}
+		results = append(results, blobRange)
+	}
+
+	return &results
+}
+
+func flattenStorageBlobRestoreRanges(input *[]storage.BlobRestoreRange) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		results = append(results, map[string]interface{}{
+			"start_range": utils.NormalizeNilableString(item.StartRange),
+			"end_range":   utils.NormalizeNilableString(item.EndRange),
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,128 @@
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/storage/mgmt/2021-09-01/storage" // nolint: staticcheck
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StorageBlobRestoreResource struct{}
+
+func TestAccStorageBlobRestore_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob_restore", "test")
+	r := StorageBlobRestoreResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.template(data),
+		},
+		{
+			// the restore point must be after the restore policy was enabled
+			PreConfig: func() { time.Sleep(2 * time.Minute) },
+			Config:    r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("Complete"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r StorageBlobRestoreResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.BlobRestoreID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Storage.AccountsClient.GetProperties(ctx, id.ResourceGroup, id.StorageAccountName, storage.AccountExpandBlobRestoreStatus)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if props := resp.AccountProperties; props != nil && props.BlobRestoreStatus != nil && props.BlobRestoreStatus.RestoreID != nil {
+		return utils.Bool(*props.BlobRestoreStatus.RestoreID == id.Name), nil
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (r StorageBlobRestoreResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  blob_properties {
+    versioning_enabled  = true
+    change_feed_enabled = true
+
+    delete_retention_policy {
+      days = 7
+    }
+
+    restore_policy {
+      days = 6
+    }
+  }
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "acctestcontainer"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "example.txt"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Block"
+  source_content         = "example"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
+func (r StorageBlobRestoreResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_blob_restore" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  time_to_restore    = timeadd(timestamp(), "-1m")
+
+  blob_range {
+    start_range = "${azurerm_storage_container.test.name}/"
+    end_range   = "${azurerm_storage_container.test.name}/z"
+  }
+
+  lifecycle {
+    ignore_changes = [time_to_restore]
+  }
+}
+`, r.template(data))
+}
@@ -0,0 +1,256 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/giovanni/storage/2019-12-12/blob/containers"
+)
+
+// the Data Plane SDK doesn't expose Blob Versions, which were introduced in API Version `2019-12-12`
+const blobVersionsDataset containers.Dataset = "versions"
+
+type blobVersionsListResult struct {
+	autorest.Response
+
+	NextMarker *string `xml:"NextMarker,omitempty"`
+	Blobs      struct {
+		Blobs []blobVersionDetails `xml:"Blob"`
+	} `xml:"Blobs"`
+}
+
+type blobVersionDetails struct {
+	Name             string  `xml:"Name"`
+	Snapshot         *string `xml:"Snapshot,omitempty"`
+	VersionId        *string `xml:"VersionId,omitempty"`
+	IsCurrentVersion *bool   `xml:"IsCurrentVersion,omitempty"`
+	Properties       struct {
+		AccessTier   *string `xml:"AccessTier,omitempty"`
+		LastModified *string `xml:"Last-Modified,omitempty"`
+	} `xml:"Properties"`
+}
+
+func dataSourceStorageBlobVersions() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceStorageBlobVersionsRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"storage_account_name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+			},
+
+			"storage_container_name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+			},
+
+			"versions": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"version_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"is_current_version": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"access_tier": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"last_modified": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"url": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"snapshots": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"snapshot": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"access_tier": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"last_modified": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"url": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceStorageBlobVersionsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	storageClient := meta.(*clients.Client).Storage
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	accountName := d.Get("storage_account_name").(string)
+	containerName := d.Get("storage_container_name").(string)
+	name := d.Get("name").(string)
+
+	account, err := storageClient.FindAccount(ctx, accountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Blob %q (Container %q): %s", accountName, name, containerName, err)
+	}
+	if account == nil {
+		return fmt.Errorf("Unable to locate Storage Account %q!", accountName)
+	}
+
+	containersClient, err := storageClient.ContainersDataPlaneClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building Containers Client: %s", err)
+	}
+
+	blobs, err := listStorageBlobVersions(ctx, containersClient, accountName, containerName, name)
+	if err != nil {
+		return fmt.Errorf("listing the versions and snapshots for Blob %q (Container %q / Account %q): %s", name, containerName, accountName, err)
+	}
+
+	blobUrl := containersClient.GetResourceID(accountName, containerName) + "/" + name
+
+	versions := make([]interface{}, 0)
+	snapshots := make([]interface{}, 0)
+	for _, blob := range blobs {
+		// the listing is based on a prefix, so may contain other Blobs
+		if blob.Name != name {
+			continue
+		}
+
+		accessTier := utils.NormalizeNilableString(blob.Properties.AccessTier)
+		lastModified := utils.NormalizeNilableString(blob.Properties.LastModified)
+
+		if blob.Snapshot != nil && *blob.Snapshot != "" {
+			snapshots = append(snapshots, map[string]interface{}{
+				"snapshot":      *blob.Snapshot,
+				"access_tier":   accessTier,
+				"last_modified": lastModified,
+				"url":           fmt.Sprintf("%s?snapshot=%s", blobUrl, url.QueryEscape(*blob.Snapshot)),
+			})
+			continue
+		}
+
+		if blob.VersionId != nil && *blob.VersionId != "" {
+			isCurrentVersion := false
+			if blob.IsCurrentVersion != nil {
+				isCurrentVersion = *blob.IsCurrentVersion
+			}
+
+			versions = append(versions, map[string]interface{}{
+				"version_id":         *blob.VersionId,
+				"is_current_version": isCurrentVersion,
+				"access_tier":        accessTier,
+				"last_modified":      lastModified,
+				"url":                fmt.Sprintf("%s?versionid=%s", blobUrl, url.QueryEscape(*blob.VersionId)),
+			})
+		}
+	}
+
+	d.SetId(blobUrl)
+
+	d.Set("name", name)
+	d.Set("storage_container_name", containerName)
+	d.Set("storage_account_name", accountName)
+
+	if err := d.Set("versions", versions); err != nil {
+		return fmt.Errorf("setting `versions`: %+v", err)
+	}
+	if err := d.Set("snapshots", snapshots); err != nil {
+		return fmt.Errorf("setting `snapshots`: %+v", err)
+	}
+
+	return nil
+}
+
+func listStorageBlobVersions(ctx context.Context, client *containers.Client, accountName, containerName, name string) ([]blobVersionDetails, error) {
+	results := make([]blobVersionDetails, 0)
+
+	input := containers.ListBlobsInput{
+		Include: &[]containers.Dataset{
+			containers.Snapshots,
+			blobVersionsDataset,
+		},
+		Prefix: utils.String(name),
+	}
+
+	for {
+		req, err := client.ListBlobsPreparer(ctx, accountName, containerName, input)
+		if err != nil {
+			return nil, fmt.Errorf("preparing request: %+v", err)
+		}
+
+		resp, err := client.ListBlobsSender(req)
+		if err != nil {
+			return nil, fmt.Errorf("sending request: %+v", err)
+		}
+
+		var result blobVersionsListResult
+		err = autorest.Respond(
+			resp,
+			client.ByInspecting(),
+			azure.WithErrorUnlessStatusCode(http.StatusOK),
+			autorest.ByUnmarshallingXML(&result),
+			autorest.ByClosing())
+		if err != nil {
+			return nil, fmt.Errorf("parsing response: %+v", err)
+		}
+
+		results = append(results, result.Blobs.Blobs...)
+
+		if result.NextMarker == nil || *result.NextMarker == "" {
+			break
+		}
+		input.Marker = result.NextMarker
+	}
+
+	return results, nil
+}
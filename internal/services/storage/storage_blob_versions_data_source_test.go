@@ -0,0 +1,80 @@
+package storage_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type StorageBlobVersionsDataSource struct{}
+
+func TestAccDataSourceStorageBlobVersions_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_blob_versions", "test")
+	d := StorageBlobVersionsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: d.template(data, "first"),
+		},
+		{
+			Config: d.basic(data, "second"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("versions.#").HasValue("2"),
+				check.That(data.ResourceName).Key("snapshots.#").HasValue("0"),
+			),
+		},
+	})
+}
+
+func (d StorageBlobVersionsDataSource) template(data acceptance.TestData, content string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  blob_properties {
+    versioning_enabled = true
+  }
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "acctestcontainer"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "example.txt"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Block"
+  source_content         = %q
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, content)
+}
+
+func (d StorageBlobVersionsDataSource) basic(data acceptance.TestData, content string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_storage_blob_versions" "test" {
+  name                   = azurerm_storage_blob.test.name
+  storage_account_name   = azurerm_storage_blob.test.storage_account_name
+  storage_container_name = azurerm_storage_blob.test.storage_container_name
+}
+`, d.template(data, content))
+}
@@ -0,0 +1,578 @@
+package storage
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/file/directories"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/file/files"
+)
+
+func resourceStorageShareDirectorySync() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceStorageShareDirectorySyncCreate,
+		Read:   resourceStorageShareDirectorySyncRead,
+		Update: resourceStorageShareDirectorySyncUpdate,
+		Delete: resourceStorageShareDirectorySyncDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := directories.ParseResourceID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"storage_share_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: storageValidate.StorageShareID,
+			},
+
+			"source_directory": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"path": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "",
+				ValidateFunc: storageValidate.StorageShareDirectoryName,
+			},
+
+			// a mapping of the relative path of each file to the (hex-encoded) MD5 hash of its content
+			"files": {
+				Type:     pluginsdk.TypeMap,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceStorageShareDirectorySyncCustomDiff),
+	}
+}
+
+// resourceStorageShareDirectorySyncCustomDiff hashes the files within the source directory, so that any files which
+// have been added, changed or removed locally are detected during the plan
+func resourceStorageShareDirectorySyncCustomDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+	if !diff.NewValueKnown("source_directory") {
+		return diff.SetNewComputed("files")
+	}
+
+	localFiles, err := hashStorageShareDirectorySyncSource(diff.Get("source_directory").(string))
+	if err != nil {
+		return err
+	}
+
+	existing := diff.Get("files").(map[string]interface{})
+	if diff.Id() == "" || !storageShareDirectorySyncFilesMatch(existing, localFiles) {
+		return diff.SetNew("files", localFiles)
+	}
+
+	return nil
+}
+
+func resourceStorageShareDirectorySyncCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	storageClient := meta.(*clients.Client).Storage
+
+	storageShareID, err := parse.StorageShareDataPlaneID(d.Get("storage_share_id").(string))
+	if err != nil {
+		return err
+	}
+
+	directoryName := d.Get("path").(string)
+
+	account, err := storageClient.FindAccount(ctx, storageShareID.AccountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Share %q: %s", storageShareID.AccountName, storageShareID.Name, err)
+	}
+	if account == nil {
+		return fmt.Errorf("unable to locate Storage Account %q!", storageShareID.AccountName)
+	}
+
+	directoriesClient, err := storageClient.FileShareDirectoriesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building File Share Directories Client: %s", err)
+	}
+
+	filesClient, err := storageClient.FileShareFilesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building File Share Files Client: %s", err)
+	}
+
+	sync := storageShareDirectorySync{
+		directoriesClient: directoriesClient,
+		filesClient:       filesClient,
+		accountName:       storageShareID.AccountName,
+		shareName:         storageShareID.Name,
+		directoryName:     directoryName,
+		sourceDirectory:   d.Get("source_directory").(string),
+	}
+
+	if err := sync.apply(ctx, map[string]interface{}{}, d.Get("files").(map[string]interface{})); err != nil {
+		return err
+	}
+
+	d.SetId(directoriesClient.GetResourceID(storageShareID.AccountName, storageShareID.Name, directoryName))
+
+	return resourceStorageShareDirectorySyncRead(d, meta)
+}
+
+func resourceStorageShareDirectorySyncUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	storageClient := meta.(*clients.Client).Storage
+
+	id, err := directories.ParseResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	account, err := storageClient.FindAccount(ctx, id.AccountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Share %q: %s", id.AccountName, id.ShareName, err)
+	}
+	if account == nil {
+		return fmt.Errorf("unable to locate Storage Account %q!", id.AccountName)
+	}
+
+	directoriesClient, err := storageClient.FileShareDirectoriesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building File Share Directories Client: %s", err)
+	}
+
+	filesClient, err := storageClient.FileShareFilesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building File Share Files Client: %s", err)
+	}
+
+	sync := storageShareDirectorySync{
+		directoriesClient: directoriesClient,
+		filesClient:       filesClient,
+		accountName:       id.AccountName,
+		shareName:         id.ShareName,
+		directoryName:     id.DirectoryName,
+		sourceDirectory:   d.Get("source_directory").(string),
+	}
+
+	if d.HasChange("files") {
+		oldRaw, newRaw := d.GetChange("files")
+		if err := sync.apply(ctx, oldRaw.(map[string]interface{}), newRaw.(map[string]interface{})); err != nil {
+			return err
+		}
+	}
+
+	return resourceStorageShareDirectorySyncRead(d, meta)
+}
+
+func resourceStorageShareDirectorySyncRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	storageClient := meta.(*clients.Client).Storage
+
+	id, err := directories.ParseResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	account, err := storageClient.FindAccount(ctx, id.AccountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Share %q: %s", id.AccountName, id.ShareName, err)
+	}
+	if account == nil {
+		log.Printf("[WARN] Unable to determine Storage Account for Storage Share Directory Sync %q (Share %s, Account %s) - assuming removed & removing from state", id.DirectoryName, id.ShareName, id.AccountName)
+		d.SetId("")
+		return nil
+	}
+
+	fileSharesClient, err := storageClient.FileSharesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building File Shares Client: %s", err)
+	}
+
+	share, err := fileSharesClient.Get(ctx, account.ResourceGroup, id.AccountName, id.ShareName)
+	if err != nil {
+		return fmt.Errorf("retrieving Share %q (Account %q): %s", id.ShareName, id.AccountName, err)
+	}
+	if share == nil {
+		log.Printf("[WARN] Unable to determine Storage Share for Storage Share Directory Sync %q (Share %s, Account %s) - assuming removed & removing from state", id.DirectoryName, id.ShareName, id.AccountName)
+		d.SetId("")
+		return nil
+	}
+
+	filesClient, err := storageClient.FileShareFilesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building File Share Files Client: %s", err)
+	}
+
+	// only the files managed by this resource are checked - any files which have been removed or modified
+	// outside of Terraform are then uploaded again during the next apply
+	remoteFiles := make(map[string]interface{})
+	for relativePath := range d.Get("files").(map[string]interface{}) {
+		directoryName, fileName := storageShareDirectorySyncRemotePath(id.DirectoryName, relativePath)
+		props, err := filesClient.GetProperties(ctx, id.AccountName, id.ShareName, directoryName, fileName)
+		if err != nil {
+			if utils.ResponseWasNotFound(props.Response) {
+				log.Printf("[DEBUG] File %q was not found in Share %q (Account %q) - removing from state", relativePath, id.ShareName, id.AccountName)
+				continue
+			}
+			return fmt.Errorf("retrieving File %q (Share %q / Account %q): %s", relativePath, id.ShareName, id.AccountName, err)
+		}
+
+		// the `Content-MD5` is always set when the file is uploaded, so when it's missing the file has been
+		// replaced outside of Terraform and is removed from the state so that it's uploaded again
+		if props.ContentMD5 == "" {
+			log.Printf("[DEBUG] File %q in Share %q (Account %q) has no Content-MD5 - removing from state", relativePath, id.ShareName, id.AccountName)
+			continue
+		}
+
+		contentMD5, err := convertBase64ToHexEncoding(props.ContentMD5)
+		if err != nil {
+			return fmt.Errorf("converting the `content_md5` for File %q: %s", relativePath, err)
+		}
+		remoteFiles[relativePath] = contentMD5
+	}
+
+	d.Set("storage_share_id", parse.NewStorageShareDataPlaneId(id.AccountName, storageClient.Environment.StorageEndpointSuffix, id.ShareName).ID())
+	d.Set("path", id.DirectoryName)
+	if err := d.Set("files", remoteFiles); err != nil {
+		return fmt.Errorf("setting `files`: %s", err)
+	}
+
+	return nil
+}
+
+func resourceStorageShareDirectorySyncDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+	storageClient := meta.(*clients.Client).Storage
+
+	id, err := directories.ParseResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	account, err := storageClient.FindAccount(ctx, id.AccountName)
+	if err != nil {
+		return fmt.Errorf("retrieving Account %q for Share %q: %s", id.AccountName, id.ShareName, err)
+	}
+	if account == nil {
+		return fmt.Errorf("unable to locate Storage Account %q", id.AccountName)
+	}
+
+	directoriesClient, err := storageClient.FileShareDirectoriesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building File Share Directories Client: %s", err)
+	}
+
+	filesClient, err := storageClient.FileShareFilesClient(ctx, *account)
+	if err != nil {
+		return fmt.Errorf("building File Share Files Client: %s", err)
+	}
+
+	sync := storageShareDirectorySync{
+		directoriesClient: directoriesClient,
+		filesClient:       filesClient,
+		accountName:       id.AccountName,
+		shareName:         id.ShareName,
+		directoryName:     id.DirectoryName,
+	}
+
+	return sync.apply(ctx, d.Get("files").(map[string]interface{}), map[string]interface{}{})
+}
+
+type storageShareDirectorySync struct {
+	directoriesClient *directories.Client
+	filesClient       *files.Client
+
+	accountName     string
+	shareName       string
+	directoryName   string
+	sourceDirectory string
+}
+
+// apply uploads the files which are new or have changed and removes the files (and any directories
+// which no longer contain files) which are no longer present
+func (s storageShareDirectorySync) apply(ctx context.Context, existing, desired map[string]interface{}) error {
+	existingDirectories := storageShareDirectorySyncDirectories(s.directoryName, existing)
+	desiredDirectories := storageShareDirectorySyncDirectories(s.directoryName, desired)
+
+	// parent directories have to be created before their children
+	for _, directoryName := range desiredDirectories {
+		if err := s.ensureDirectory(ctx, directoryName); err != nil {
+			return err
+		}
+	}
+
+	for _, relativePath := range storageShareDirectorySyncSortedKeys(desired) {
+		contentMD5 := desired[relativePath].(string)
+		if v, ok := existing[relativePath]; ok && v.(string) == contentMD5 {
+			continue
+		}
+
+		if err := s.uploadFile(ctx, relativePath, contentMD5); err != nil {
+			return err
+		}
+	}
+
+	for _, relativePath := range storageShareDirectorySyncSortedKeys(existing) {
+		if _, ok := desired[relativePath]; ok {
+			continue
+		}
+
+		directoryName, fileName := storageShareDirectorySyncRemotePath(s.directoryName, relativePath)
+		log.Printf("[DEBUG] Deleting File %q (Share %q / Account %q)..", relativePath, s.shareName, s.accountName)
+		if resp, err := s.filesClient.Delete(ctx, s.accountName, s.shareName, directoryName, fileName); err != nil {
+			if !utils.ResponseWasNotFound(resp) {
+				return fmt.Errorf("deleting File %q (Share %q / Account %q): %s", relativePath, s.shareName, s.accountName, err)
+			}
+		}
+	}
+
+	// child directories have to be removed before their parents
+	desiredLookup := make(map[string]struct{}, len(desiredDirectories))
+	for _, directoryName := range desiredDirectories {
+		desiredLookup[directoryName] = struct{}{}
+	}
+	for i := len(existingDirectories) - 1; i >= 0; i-- {
+		directoryName := existingDirectories[i]
+		if _, ok := desiredLookup[directoryName]; ok {
+			continue
+		}
+
+		// the directory may contain files which aren't managed by Terraform, in which case it's retained
+		if resp, err := s.directoriesClient.Delete(ctx, s.accountName, s.shareName, directoryName); err != nil && !utils.ResponseWasNotFound(resp) {
+			log.Printf("[DEBUG] Unable to delete Directory %q (Share %q / Account %q) - retaining: %s", directoryName, s.shareName, s.accountName, err)
+		}
+	}
+
+	return nil
+}
+
+func (s storageShareDirectorySync) ensureDirectory(ctx context.Context, directoryName string) error {
+	existing, err := s.directoriesClient.Get(ctx, s.accountName, s.shareName, directoryName)
+	if err == nil {
+		return nil
+	}
+	if !utils.ResponseWasNotFound(existing.Response) {
+		return fmt.Errorf("checking for presence of existing Directory %q (Share %q / Account %q): %s", directoryName, s.shareName, s.accountName, err)
+	}
+
+	log.Printf("[DEBUG] Creating Directory %q (Share %q / Account %q)..", directoryName, s.shareName, s.accountName)
+	if _, err := s.directoriesClient.Create(ctx, s.accountName, s.shareName, directoryName, directories.CreateDirectoryInput{}); err != nil {
+		return fmt.Errorf("creating Directory %q (Share %q / Account %q): %s", directoryName, s.shareName, s.accountName, err)
+	}
+
+	return nil
+}
+
+func (s storageShareDirectorySync) uploadFile(ctx context.Context, relativePath string, contentMD5 string) error {
+	directoryName, fileName := storageShareDirectorySyncRemotePath(s.directoryName, relativePath)
+
+	file, err := os.Open(filepath.Join(s.sourceDirectory, filepath.FromSlash(relativePath)))
+	if err != nil {
+		return fmt.Errorf("opening File %q: %s", relativePath, err)
+	}
+	defer file.Close()
+
+	// the file may have been modified since the plan was generated
+	actualMD5, err := hashStorageShareDirectorySyncFile(file)
+	if err != nil {
+		return fmt.Errorf("hashing File %q: %s", relativePath, err)
+	}
+	if actualMD5 != contentMD5 {
+		return fmt.Errorf("the content of File %q has changed since the plan was generated", relativePath)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("'stat'-ing File %q: %s", relativePath, err)
+	}
+
+	encodedMD5, err := convertHexToBase64Encoding(contentMD5)
+	if err != nil {
+		return err
+	}
+
+	contentType := mime.TypeByExtension(path.Ext(fileName))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	log.Printf("[DEBUG] Uploading File %q (Share %q / Account %q)..", relativePath, s.shareName, s.accountName)
+	input := files.CreateInput{
+		ContentLength: info.Size(),
+		ContentMD5:    utils.String(encodedMD5),
+		ContentType:   utils.String(contentType),
+	}
+	if _, err := s.filesClient.Create(ctx, s.accountName, s.shareName, directoryName, fileName, input); err != nil {
+		return fmt.Errorf("creating File %q (Share %q / Account %q): %s", relativePath, s.shareName, s.accountName, err)
+	}
+
+	// empty files don't have any content to upload
+	if info.Size() > 0 {
+		if err := s.filesClient.PutFile(ctx, s.accountName, s.shareName, directoryName, fileName, file, 4); err != nil {
+			return fmt.Errorf("uploading File %q (Share %q / Account %q): %s", relativePath, s.shareName, s.accountName, err)
+		}
+	}
+
+	return nil
+}
+
+// hashStorageShareDirectorySyncSource returns a mapping of the relative path of each file within the source
+// directory (using `/` as the separator) to the hex-encoded MD5 hash of its content
+func hashStorageShareDirectorySyncSource(sourceDirectory string) (map[string]interface{}, error) {
+	results := make(map[string]interface{})
+
+	err := filepath.WalkDir(sourceDirectory, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		relativePath, err := filepath.Rel(sourceDirectory, filePath)
+		if err != nil {
+			return err
+		}
+
+		file, err := os.Open(filePath)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		contentMD5, err := hashStorageShareDirectorySyncFile(file)
+		if err != nil {
+			return err
+		}
+
+		results[filepath.ToSlash(relativePath)] = contentMD5
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("reading the source directory %q: %+v", sourceDirectory, err)
+	}
+
+	return results, nil
+}
+
+func hashStorageShareDirectorySyncFile(file *os.File) (string, error) {
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	hash := md5.New() // nolint: gosec
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func storageShareDirectorySyncFilesMatch(existing, desired map[string]interface{}) bool {
+	if len(existing) != len(desired) {
+		return false
+	}
+
+	for k, v := range desired {
+		if existing[k] != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+// storageShareDirectorySyncRemotePath returns the Directory and File Name within the Share for the relative path
+func storageShareDirectorySyncRemotePath(directoryName, relativePath string) (string, string) {
+	remotePath := path.Join(directoryName, relativePath)
+	remoteDirectory, fileName := path.Split(remotePath)
+	return strings.TrimSuffix(remoteDirectory, "/"), fileName
+}
+
+// storageShareDirectorySyncDirectories returns the Directories within the Share (including the root Directory)
+// which contain the specified files, with parent Directories sorted before their children
+func storageShareDirectorySyncDirectories(directoryName string, input map[string]interface{}) []string {
+	lookup := make(map[string]struct{})
+
+	addDirectory := func(remoteDirectory string) {
+		segments := strings.Split(remoteDirectory, "/")
+		for i := range segments {
+			if v := strings.Join(segments[:i+1], "/"); v != "" {
+				lookup[v] = struct{}{}
+			}
+		}
+	}
+
+	if directoryName != "" {
+		addDirectory(directoryName)
+	}
+	for relativePath := range input {
+		remoteDirectory, _ := storageShareDirectorySyncRemotePath(directoryName, relativePath)
+		if remoteDirectory != "" {
+			addDirectory(remoteDirectory)
+		}
+	}
+
+	results := make([]string, 0, len(lookup))
+	for k := range lookup {
+		results = append(results, k)
+	}
+	sort.Slice(results, func(i, j int) bool {
+		depthI := strings.Count(results[i], "/")
+		depthJ := strings.Count(results[j], "/")
+		if depthI != depthJ {
+			return depthI < depthJ
+		}
+		return results[i] < results[j]
+	})
+
+	return results
+}
+
+func storageShareDirectorySyncSortedKeys(input map[string]interface{}) []string {
+	results := make([]string, 0, len(input))
+	for k := range input {
+		results = append(results, k)
+	}
+	sort.Strings(results)
+	return results
+}
@@ -0,0 +1,179 @@
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/tombuildsstuff/giovanni/storage/2020-08-04/file/directories"
+)
+
+type StorageShareDirectorySyncResource struct{}
+
+func TestAccStorageShareDirectorySync_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_share_directory_sync", "test")
+	r := StorageShareDirectorySyncResource{}
+
+	sourceDirectory := t.TempDir()
+	writeTestFile(t, sourceDirectory, "config.json", `{"hello":"world"}`)
+	writeTestFile(t, sourceDirectory, "nested/example.txt", "example")
+	writeTestFile(t, sourceDirectory, "nested/deeper/removed.txt", "removed")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, sourceDirectory),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("files.%").HasValue("3"),
+			),
+		},
+		data.ImportStep("source_directory", "files"),
+		{
+			PreConfig: func() {
+				writeTestFile(t, sourceDirectory, "nested/example.txt", "updated")
+				writeTestFile(t, sourceDirectory, "added.txt", "added")
+				if err := os.RemoveAll(filepath.Join(sourceDirectory, "nested", "deeper")); err != nil {
+					t.Fatalf("removing directory: %+v", err)
+				}
+			},
+			Config: r.basic(data, sourceDirectory),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("files.%").HasValue("3"),
+				check.That(data.ResourceName).Key("files.added.txt").Exists(),
+				check.That(data.ResourceName).Key("files.nested/deeper/removed.txt").DoesNotExist(),
+			),
+		},
+		data.ImportStep("source_directory", "files"),
+	})
+}
+
+func TestAccStorageShareDirectorySync_path(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_share_directory_sync", "test")
+	r := StorageShareDirectorySyncResource{}
+
+	sourceDirectory := t.TempDir()
+	writeTestFile(t, sourceDirectory, "example.txt", "example")
+	writeTestFile(t, sourceDirectory, "nested/empty.txt", "")
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.path(data, sourceDirectory),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("files.%").HasValue("2"),
+			),
+		},
+		data.ImportStep("source_directory", "files"),
+	})
+}
+
+func writeTestFile(t *testing.T, directory, name, content string) {
+	filePath := filepath.Join(directory, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+		t.Fatalf("creating directory for %q: %+v", name, err)
+	}
+	if err := os.WriteFile(filePath, []byte(content), 0o600); err != nil {
+		t.Fatalf("writing %q: %+v", name, err)
+	}
+}
+
+func (r StorageShareDirectorySyncResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := directories.ParseResourceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := client.Storage.FindAccount(ctx, id.AccountName)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving Account %q for Share %q: %+v", id.AccountName, id.ShareName, err)
+	}
+	if account == nil {
+		return utils.Bool(false), nil
+	}
+
+	filesClient, err := client.Storage.FileShareFilesClient(ctx, *account)
+	if err != nil {
+		return nil, fmt.Errorf("building File Share Files Client: %+v", err)
+	}
+
+	// the resource exists when every file tracked in the state exists within the Share
+	for key := range state.Attributes {
+		if !strings.HasPrefix(key, "files.") || key == "files.%" {
+			continue
+		}
+
+		remotePath := path.Join(id.DirectoryName, strings.TrimPrefix(key, "files."))
+		directoryName, fileName := path.Split(remotePath)
+		directoryName = strings.TrimSuffix(directoryName, "/")
+
+		resp, err := filesClient.GetProperties(ctx, id.AccountName, id.ShareName, directoryName, fileName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return utils.Bool(false), nil
+			}
+			return nil, fmt.Errorf("retrieving File %q (Share %q / Account %q): %+v", remotePath, id.ShareName, id.AccountName, err)
+		}
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r StorageShareDirectorySyncResource) basic(data acceptance.TestData, sourceDirectory string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_share_directory_sync" "test" {
+  storage_share_id = azurerm_storage_share.test.id
+  source_directory = %q
+}
+`, r.template(data), sourceDirectory)
+}
+
+func (r StorageShareDirectorySyncResource) path(data acceptance.TestData, sourceDirectory string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_share_directory_sync" "test" {
+  storage_share_id = azurerm_storage_share.test.id
+  source_directory = %q
+  path             = "parent/child"
+}
+`, r.template(data), sourceDirectory)
+}
+
+func (r StorageShareDirectorySyncResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_share" "test" {
+  name                 = "fileshare"
+  storage_account_name = azurerm_storage_account.test.name
+  quota                = 50
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
@@ -0,0 +1,46 @@
+package client
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/sdk/2023-10-01/agents"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/sdk/2023-10-01/endpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/sdk/2023-10-01/jobdefinitions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/sdk/2023-10-01/projects"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/sdk/2023-10-01/storagemovers"
+)
+
+// NOTE: the Storage Mover SDK is embedded since the vendored SDK doesn't include `Microsoft.StorageMover` - API Version
+// `2023-10-01` is used since it's the first to support the SMB Mount and Azure Storage SMB File Share Endpoints
+
+type Client struct {
+	AgentsClient         *agents.AgentsClient
+	EndpointsClient      *endpoints.EndpointsClient
+	JobDefinitionsClient *jobdefinitions.JobDefinitionsClient
+	ProjectsClient       *projects.ProjectsClient
+	StorageMoversClient  *storagemovers.StorageMoversClient
+}
+
+func NewClient(o *common.ClientOptions) *Client {
+	agentsClient := agents.NewAgentsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&agentsClient.Client, o.ResourceManagerAuthorizer)
+
+	endpointsClient := endpoints.NewEndpointsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&endpointsClient.Client, o.ResourceManagerAuthorizer)
+
+	jobDefinitionsClient := jobdefinitions.NewJobDefinitionsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&jobDefinitionsClient.Client, o.ResourceManagerAuthorizer)
+
+	projectsClient := projects.NewProjectsClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&projectsClient.Client, o.ResourceManagerAuthorizer)
+
+	storageMoversClient := storagemovers.NewStorageMoversClientWithBaseURI(o.ResourceManagerEndpoint)
+	o.ConfigureClient(&storageMoversClient.Client, o.ResourceManagerAuthorizer)
+
+	return &Client{
+		AgentsClient:         &agentsClient,
+		EndpointsClient:      &endpointsClient,
+		JobDefinitionsClient: &jobDefinitionsClient,
+		ProjectsClient:       &projectsClient,
+		StorageMoversClient:  &storageMoversClient,
+	}
+}
@@ -0,0 +1,32 @@
+package storagemover
+
+import "github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+
+type Registration struct{}
+
+func (r Registration) WebsiteCategories() []string {
+	return []string{
+		"Storage Mover",
+	}
+}
+
+func (r Registration) Name() string {
+	return "Storage Mover"
+}
+
+func (r Registration) DataSources() []sdk.DataSource {
+	return []sdk.DataSource{}
+}
+
+func (r Registration) Resources() []sdk.Resource {
+	return []sdk.Resource{
+		StorageMoverResource{},
+		StorageMoverAgentResource{},
+		StorageMoverJobDefinitionResource{},
+		StorageMoverProjectResource{},
+		StorageMoverSourceEndpointResource{},
+		StorageMoverTargetEndpointResource{},
+	}
+}
+
+var _ sdk.TypedServiceRegistration = Registration{}
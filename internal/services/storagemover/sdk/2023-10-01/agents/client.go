@@ -0,0 +1,18 @@
+package agents
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type AgentsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewAgentsClientWithBaseURI(endpoint string) AgentsClient {
+	return AgentsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,8 @@
+package agents
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type AgentUpdateParameters struct {
+	Properties *AgentUpdateProperties `json:"properties,omitempty"`
+}
@@ -0,0 +1,10 @@
+package endpoints
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type AzureKeyVaultSmbCredentials struct {
+	PasswordUri *string        `json:"passwordUri,omitempty"`
+	Type        CredentialType `json:"type"`
+	UsernameUri *string        `json:"usernameUri,omitempty"`
+}
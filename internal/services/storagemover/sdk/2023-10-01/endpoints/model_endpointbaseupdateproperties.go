@@ -0,0 +1,7 @@
+package endpoints
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type EndpointBaseUpdateProperties interface {
+}
@@ -0,0 +1,18 @@
+package jobdefinitions
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type JobDefinitionsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewJobDefinitionsClientWithBaseURI(endpoint string) JobDefinitionsClient {
+	return JobDefinitionsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
@@ -0,0 +1,18 @@
+package projects
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type ProjectsClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewProjectsClientWithBaseURI(endpoint string) ProjectsClient {
+	return ProjectsClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
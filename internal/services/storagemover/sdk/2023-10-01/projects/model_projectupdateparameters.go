@@ -0,0 +1,8 @@
+package projects
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type ProjectUpdateParameters struct {
+	Properties *ProjectUpdateProperties `json:"properties,omitempty"`
+}
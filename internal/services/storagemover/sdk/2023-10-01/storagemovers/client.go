@@ -0,0 +1,18 @@
+package storagemovers
+
+import "github.com/Azure/go-autorest/autorest"
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type StorageMoversClient struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func NewStorageMoversClientWithBaseURI(endpoint string) StorageMoversClient {
+	return StorageMoversClient{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
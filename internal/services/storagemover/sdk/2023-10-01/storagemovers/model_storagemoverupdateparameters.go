@@ -0,0 +1,9 @@
+package storagemovers
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type StorageMoverUpdateParameters struct {
+	Properties *StorageMoverUpdateProperties `json:"properties,omitempty"`
+	Tags       *map[string]string            `json:"tags,omitempty"`
+}
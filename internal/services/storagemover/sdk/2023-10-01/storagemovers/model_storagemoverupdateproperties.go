@@ -0,0 +1,8 @@
+package storagemovers
+
+// Copyright (c) Microsoft Corporation. All rights reserved.
+// Licensed under the MIT License. See NOTICE.txt in the project root for license information.
+
+type StorageMoverUpdateProperties struct {
+	Description *string `json:"description,omitempty"`
+}
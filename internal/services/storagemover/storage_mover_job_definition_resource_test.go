@@ -0,0 +1,185 @@
+package storagemover_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/sdk/2023-10-01/jobdefinitions"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StorageMoverJobDefinitionResource struct{}
+
+func TestAccStorageMoverJobDefinition_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_mover_job_definition", "test")
+	r := StorageMoverJobDefinitionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageMoverJobDefinition_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_mover_job_definition", "test")
+	r := StorageMoverJobDefinitionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccStorageMoverJobDefinition_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_mover_job_definition", "test")
+	r := StorageMoverJobDefinitionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageMoverJobDefinition_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_mover_job_definition", "test")
+	r := StorageMoverJobDefinitionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r StorageMoverJobDefinitionResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := jobdefinitions.ParseJobDefinitionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.StorageMover.JobDefinitionsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r StorageMoverJobDefinitionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_mover_job_definition" "test" {
+  name                     = "acctest-sjd-%d"
+  storage_mover_project_id = azurerm_storage_mover_project.test.id
+  source_name              = azurerm_storage_mover_source_endpoint.test.name
+  target_name              = azurerm_storage_mover_target_endpoint.test.name
+  copy_mode                = "Additive"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r StorageMoverJobDefinitionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_mover_job_definition" "import" {
+  name                     = azurerm_storage_mover_job_definition.test.name
+  storage_mover_project_id = azurerm_storage_mover_job_definition.test.storage_mover_project_id
+  source_name              = azurerm_storage_mover_job_definition.test.source_name
+  target_name              = azurerm_storage_mover_job_definition.test.target_name
+  copy_mode                = azurerm_storage_mover_job_definition.test.copy_mode
+}
+`, r.basic(data))
+}
+
+func (r StorageMoverJobDefinitionResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_mover_job_definition" "test" {
+  name                     = "acctest-sjd-%d"
+  storage_mover_project_id = azurerm_storage_mover_project.test.id
+  source_name              = azurerm_storage_mover_source_endpoint.test.name
+  source_sub_path          = "source"
+  target_name              = azurerm_storage_mover_target_endpoint.test.name
+  target_sub_path          = "target"
+  copy_mode                = "Mirror"
+  description              = "Example Job Definition Description"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r StorageMoverJobDefinitionResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_mover_job_definition" "test" {
+  name                     = "acctest-sjd-%d"
+  storage_mover_project_id = azurerm_storage_mover_project.test.id
+  source_name              = azurerm_storage_mover_source_endpoint.test.name
+  target_name              = azurerm_storage_mover_target_endpoint.test.name
+  copy_mode                = "Mirror"
+  description              = "Updated Job Definition Description"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r StorageMoverJobDefinitionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_mover_source_endpoint" "test" {
+  name             = "acctest-smse-%[2]d"
+  storage_mover_id = azurerm_storage_mover.test.id
+  host             = "192.168.0.1"
+  export           = "/"
+}
+
+resource "azurerm_storage_mover_target_endpoint" "test" {
+  name                 = "acctest-smte-%[2]d"
+  storage_mover_id     = azurerm_storage_mover.test.id
+  storage_container_id = azurerm_storage_container.test.resource_manager_id
+}
+
+resource "azurerm_storage_mover_project" "test" {
+  name             = "acctest-sp-%[2]d"
+  storage_mover_id = azurerm_storage_mover.test.id
+}
+`, StorageMoverTargetEndpointResource{}.template(data), data.RandomInteger)
+}
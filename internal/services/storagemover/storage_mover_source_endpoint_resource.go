@@ -0,0 +1,319 @@
+package storagemover
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/sdk/2023-10-01/endpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/sdk/2023-10-01/storagemovers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type StorageMoverSourceEndpointResourceModel struct {
+	Name                     string `tfschema:"name"`
+	StorageMoverId           string `tfschema:"storage_mover_id"`
+	Host                     string `tfschema:"host"`
+	Export                   string `tfschema:"export"`
+	NfsVersion               string `tfschema:"nfs_version"`
+	ShareName                string `tfschema:"share_name"`
+	UsernameKeyVaultSecretId string `tfschema:"username_key_vault_secret_id"`
+	PasswordKeyVaultSecretId string `tfschema:"password_key_vault_secret_id"`
+	Description              string `tfschema:"description"`
+}
+
+type StorageMoverSourceEndpointResource struct{}
+
+var _ sdk.ResourceWithUpdate = StorageMoverSourceEndpointResource{}
+
+func (r StorageMoverSourceEndpointResource) ResourceType() string {
+	return "azurerm_storage_mover_source_endpoint"
+}
+
+func (r StorageMoverSourceEndpointResource) ModelObject() interface{} {
+	return &StorageMoverSourceEndpointResourceModel{}
+}
+
+func (r StorageMoverSourceEndpointResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return endpoints.ValidateEndpointID
+}
+
+func (r StorageMoverSourceEndpointResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"storage_mover_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: storagemovers.ValidateStorageMoverID,
+		},
+
+		"host": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		// NFS Mount
+		"export": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			ExactlyOneOf: []string{"export", "share_name"},
+		},
+
+		"nfs_version": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ForceNew:      true,
+			ValidateFunc:  validation.StringInSlice(endpoints.PossibleValuesForNfsVersion(), false),
+			ConflictsWith: []string{"share_name"},
+		},
+
+		// SMB Mount
+		"share_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			ExactlyOneOf: []string{"export", "share_name"},
+		},
+
+		"username_key_vault_secret_id": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ValidateFunc:  keyVaultValidate.NestedItemIdWithOptionalVersion,
+			ConflictsWith: []string{"export"},
+			RequiredWith:  []string{"password_key_vault_secret_id"},
+		},
+
+		"password_key_vault_secret_id": {
+			Type:          pluginsdk.TypeString,
+			Optional:      true,
+			ValidateFunc:  keyVaultValidate.NestedItemIdWithOptionalVersion,
+			ConflictsWith: []string{"export"},
+			RequiredWith:  []string{"username_key_vault_secret_id"},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r StorageMoverSourceEndpointResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StorageMoverSourceEndpointResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model StorageMoverSourceEndpointResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.StorageMover.EndpointsClient
+
+			storageMoverId, err := storagemovers.ParseStorageMoverID(model.StorageMoverId)
+			if err != nil {
+				return err
+			}
+
+			id := endpoints.NewEndpointID(storageMoverId.SubscriptionId, storageMoverId.ResourceGroupName, storageMoverId.StorageMoverName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			var description *string
+			if model.Description != "" {
+				description = pointer.To(model.Description)
+			}
+
+			var properties endpoints.EndpointBaseProperties
+			if model.ShareName != "" {
+				smbProperties := endpoints.SmbMountEndpointProperties{
+					Description: description,
+					Host:        model.Host,
+					ShareName:   model.ShareName,
+				}
+				if model.UsernameKeyVaultSecretId != "" {
+					smbProperties.Credentials = expandStorageMoverSmbCredentials(model.UsernameKeyVaultSecretId, model.PasswordKeyVaultSecretId)
+				}
+				properties = smbProperties
+			} else {
+				nfsVersion := endpoints.NfsVersionNFSauto
+				if model.NfsVersion != "" {
+					nfsVersion = endpoints.NfsVersion(model.NfsVersion)
+				}
+
+				properties = endpoints.NfsMountEndpointProperties{
+					Description: description,
+					Export:      model.Export,
+					Host:        model.Host,
+					NfsVersion:  pointer.To(nfsVersion),
+				}
+			}
+
+			payload := endpoints.Endpoint{
+				Properties: properties,
+			}
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StorageMoverSourceEndpointResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageMover.EndpointsClient
+
+			id, err := endpoints.ParseEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := StorageMoverSourceEndpointResourceModel{
+				Name:           id.EndpointName,
+				StorageMoverId: storagemovers.NewStorageMoverID(id.SubscriptionId, id.ResourceGroupName, id.StorageMoverName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				switch props := model.Properties.(type) {
+				case endpoints.NfsMountEndpointProperties:
+					state.Description = pointer.From(props.Description)
+					state.Export = props.Export
+					state.Host = props.Host
+					state.NfsVersion = string(pointer.From(props.NfsVersion))
+
+				case endpoints.SmbMountEndpointProperties:
+					state.Description = pointer.From(props.Description)
+					state.Host = props.Host
+					state.ShareName = props.ShareName
+					if credentials := props.Credentials; credentials != nil {
+						state.PasswordKeyVaultSecretId = pointer.From(credentials.PasswordUri)
+						state.UsernameKeyVaultSecretId = pointer.From(credentials.UsernameUri)
+					}
+
+				default:
+					return fmt.Errorf("retrieving %s: expected a NFS Mount or SMB Mount Endpoint but got %T", *id, model.Properties)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageMoverSourceEndpointResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageMover.EndpointsClient
+
+			id, err := endpoints.ParseEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model StorageMoverSourceEndpointResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			var description *string
+			if metadata.ResourceData.HasChange("description") {
+				description = pointer.To(model.Description)
+			}
+
+			var properties endpoints.EndpointBaseUpdateProperties
+			if model.ShareName != "" {
+				smbProperties := endpoints.SmbMountEndpointUpdateProperties{
+					Description: description,
+				}
+				if metadata.ResourceData.HasChanges("username_key_vault_secret_id", "password_key_vault_secret_id") {
+					smbProperties.Credentials = expandStorageMoverSmbCredentials(model.UsernameKeyVaultSecretId, model.PasswordKeyVaultSecretId)
+				}
+				properties = smbProperties
+			} else {
+				properties = endpoints.NfsMountEndpointUpdateProperties{
+					Description: description,
+				}
+			}
+
+			parameters := endpoints.EndpointBaseUpdateParameters{
+				Properties: properties,
+			}
+			if _, err := client.Update(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageMoverSourceEndpointResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageMover.EndpointsClient
+
+			id, err := endpoints.ParseEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandStorageMoverSmbCredentials(usernameSecretId, passwordSecretId string) *endpoints.AzureKeyVaultSmbCredentials {
+	// the credentials are removed from an existing Endpoint by sending empty Secret URIs
+	return &endpoints.AzureKeyVaultSmbCredentials{
+		Type:        endpoints.CredentialTypeAzureKeyVaultSmb,
+		UsernameUri: pointer.To(usernameSecretId),
+		PasswordUri: pointer.To(passwordSecretId),
+	}
+}
@@ -0,0 +1,220 @@
+package storagemover_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/sdk/2023-10-01/endpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StorageMoverSourceEndpointResource struct{}
+
+func TestAccStorageMoverSourceEndpoint_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_mover_source_endpoint", "test")
+	r := StorageMoverSourceEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("nfs_version").HasValue("NFSauto"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageMoverSourceEndpoint_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_mover_source_endpoint", "test")
+	r := StorageMoverSourceEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccStorageMoverSourceEndpoint_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_mover_source_endpoint", "test")
+	r := StorageMoverSourceEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageMoverSourceEndpoint_smbMount(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_mover_source_endpoint", "test")
+	r := StorageMoverSourceEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.smbMount(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.smbMountWithCredentials(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r StorageMoverSourceEndpointResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := endpoints.ParseEndpointID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.StorageMover.EndpointsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r StorageMoverSourceEndpointResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_mover_source_endpoint" "test" {
+  name             = "acctest-smse-%d"
+  storage_mover_id = azurerm_storage_mover.test.id
+  host             = "192.168.0.1"
+  export           = "/"
+}
+`, StorageMoverResource{}.basic(data), data.RandomInteger)
+}
+
+func (r StorageMoverSourceEndpointResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_mover_source_endpoint" "import" {
+  name             = azurerm_storage_mover_source_endpoint.test.name
+  storage_mover_id = azurerm_storage_mover_source_endpoint.test.storage_mover_id
+  host             = azurerm_storage_mover_source_endpoint.test.host
+  export           = azurerm_storage_mover_source_endpoint.test.export
+}
+`, r.basic(data))
+}
+
+func (r StorageMoverSourceEndpointResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_mover_source_endpoint" "test" {
+  name             = "acctest-smse-%d"
+  storage_mover_id = azurerm_storage_mover.test.id
+  host             = "192.168.0.1"
+  export           = "/"
+  nfs_version      = "NFSauto"
+  description      = "Example Source Endpoint Description"
+}
+`, StorageMoverResource{}.basic(data), data.RandomInteger)
+}
+
+func (r StorageMoverSourceEndpointResource) smbMount(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_mover_source_endpoint" "test" {
+  name             = "acctest-smse-%d"
+  storage_mover_id = azurerm_storage_mover.test.id
+  host             = "192.168.0.1"
+  share_name       = "share1"
+}
+`, r.smbTemplate(data), data.RandomInteger)
+}
+
+func (r StorageMoverSourceEndpointResource) smbMountWithCredentials(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_mover_source_endpoint" "test" {
+  name                         = "acctest-smse-%d"
+  storage_mover_id             = azurerm_storage_mover.test.id
+  host                         = "192.168.0.1"
+  share_name                   = "share1"
+  username_key_vault_secret_id = azurerm_key_vault_secret.username.versionless_id
+  password_key_vault_secret_id = azurerm_key_vault_secret.password.versionless_id
+  description                  = "Example SMB Source Endpoint Description"
+}
+`, r.smbTemplate(data), data.RandomInteger)
+}
+
+func (r StorageMoverSourceEndpointResource) smbTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_key_vault" "test" {
+  name                       = "acctestkv-%s"
+  location                   = azurerm_resource_group.test.location
+  resource_group_name        = azurerm_resource_group.test.name
+  tenant_id                  = data.azurerm_client_config.current.tenant_id
+  sku_name                   = "standard"
+  soft_delete_retention_days = 7
+
+  access_policy {
+    tenant_id = data.azurerm_client_config.current.tenant_id
+    object_id = data.azurerm_client_config.current.object_id
+
+    secret_permissions = [
+      "Delete",
+      "Get",
+      "Purge",
+      "Set",
+    ]
+  }
+}
+
+resource "azurerm_key_vault_secret" "username" {
+  name         = "username"
+  value        = "adminuser"
+  key_vault_id = azurerm_key_vault.test.id
+}
+
+resource "azurerm_key_vault_secret" "password" {
+  name         = "password"
+  value        = "P@ssw0rd1234!"
+  key_vault_id = azurerm_key_vault.test.id
+}
+`, StorageMoverResource{}.basic(data), data.RandomString)
+}
@@ -0,0 +1,273 @@
+package storagemover
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	storageParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	storageValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/sdk/2023-10-01/endpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/sdk/2023-10-01/storagemovers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type StorageMoverTargetEndpointResourceModel struct {
+	Name               string `tfschema:"name"`
+	StorageMoverId     string `tfschema:"storage_mover_id"`
+	StorageContainerId string `tfschema:"storage_container_id"`
+	StorageShareId     string `tfschema:"storage_share_id"`
+	Description        string `tfschema:"description"`
+}
+
+type StorageMoverTargetEndpointResource struct{}
+
+var _ sdk.ResourceWithUpdate = StorageMoverTargetEndpointResource{}
+
+func (r StorageMoverTargetEndpointResource) ResourceType() string {
+	return "azurerm_storage_mover_target_endpoint"
+}
+
+func (r StorageMoverTargetEndpointResource) ModelObject() interface{} {
+	return &StorageMoverTargetEndpointResourceModel{}
+}
+
+func (r StorageMoverTargetEndpointResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return endpoints.ValidateEndpointID
+}
+
+func (r StorageMoverTargetEndpointResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"storage_mover_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: storagemovers.ValidateStorageMoverID,
+		},
+
+		// the `resource_manager_id` of the `azurerm_storage_container` / `azurerm_storage_share` resources
+		"storage_container_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: storageValidate.StorageContainerResourceManagerID,
+			ExactlyOneOf: []string{"storage_container_id", "storage_share_id"},
+		},
+
+		"storage_share_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: storageValidate.StorageShareResourceManagerID,
+			ExactlyOneOf: []string{"storage_container_id", "storage_share_id"},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r StorageMoverTargetEndpointResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StorageMoverTargetEndpointResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model StorageMoverTargetEndpointResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			client := metadata.Client.StorageMover.EndpointsClient
+
+			storageMoverId, err := storagemovers.ParseStorageMoverID(model.StorageMoverId)
+			if err != nil {
+				return err
+			}
+
+			id := endpoints.NewEndpointID(storageMoverId.SubscriptionId, storageMoverId.ResourceGroupName, storageMoverId.StorageMoverName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for the presence of an existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			var description *string
+			if model.Description != "" {
+				description = pointer.To(model.Description)
+			}
+
+			var properties endpoints.EndpointBaseProperties
+			if model.StorageContainerId != "" {
+				containerId, err := storageParse.StorageContainerResourceManagerID(model.StorageContainerId)
+				if err != nil {
+					return err
+				}
+
+				properties = endpoints.AzureStorageBlobContainerEndpointProperties{
+					BlobContainerName:        containerId.ContainerName,
+					Description:              description,
+					StorageAccountResourceId: storageParse.NewStorageAccountID(containerId.SubscriptionId, containerId.ResourceGroup, containerId.StorageAccountName).ID(),
+				}
+			} else {
+				shareId, err := storageParse.StorageShareResourceManagerID(model.StorageShareId)
+				if err != nil {
+					return err
+				}
+
+				properties = endpoints.AzureStorageSmbFileShareEndpointProperties{
+					Description:              description,
+					FileShareName:            shareId.FileshareName,
+					StorageAccountResourceId: storageParse.NewStorageAccountID(shareId.SubscriptionId, shareId.ResourceGroup, shareId.StorageAccountName).ID(),
+				}
+			}
+
+			payload := endpoints.Endpoint{
+				Properties: properties,
+			}
+			if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StorageMoverTargetEndpointResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageMover.EndpointsClient
+
+			id, err := endpoints.ParseEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := StorageMoverTargetEndpointResourceModel{
+				Name:           id.EndpointName,
+				StorageMoverId: storagemovers.NewStorageMoverID(id.SubscriptionId, id.ResourceGroupName, id.StorageMoverName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				switch props := model.Properties.(type) {
+				case endpoints.AzureStorageBlobContainerEndpointProperties:
+					storageAccountId, err := storageParse.StorageAccountID(props.StorageAccountResourceId)
+					if err != nil {
+						return err
+					}
+
+					state.Description = pointer.From(props.Description)
+					state.StorageContainerId = storageParse.NewStorageContainerResourceManagerID(storageAccountId.SubscriptionId, storageAccountId.ResourceGroup, storageAccountId.Name, "default", props.BlobContainerName).ID()
+
+				case endpoints.AzureStorageSmbFileShareEndpointProperties:
+					storageAccountId, err := storageParse.StorageAccountID(props.StorageAccountResourceId)
+					if err != nil {
+						return err
+					}
+
+					state.Description = pointer.From(props.Description)
+					state.StorageShareId = storageParse.NewStorageShareResourceManagerID(storageAccountId.SubscriptionId, storageAccountId.ResourceGroup, storageAccountId.Name, "default", props.FileShareName).ID()
+
+				default:
+					return fmt.Errorf("retrieving %s: expected an Azure Storage Blob Container or Azure Storage SMB File Share Endpoint but got %T", *id, model.Properties)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageMoverTargetEndpointResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageMover.EndpointsClient
+
+			id, err := endpoints.ParseEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model StorageMoverTargetEndpointResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			var description *string
+			if metadata.ResourceData.HasChange("description") {
+				description = pointer.To(model.Description)
+			}
+
+			var properties endpoints.EndpointBaseUpdateProperties
+			if model.StorageContainerId != "" {
+				properties = endpoints.AzureStorageBlobContainerEndpointUpdateProperties{
+					Description: description,
+				}
+			} else {
+				properties = endpoints.AzureStorageSmbFileShareEndpointUpdateProperties{
+					Description: description,
+				}
+			}
+
+			parameters := endpoints.EndpointBaseUpdateParameters{
+				Properties: properties,
+			}
+			if _, err := client.Update(ctx, *id, parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StorageMoverTargetEndpointResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.StorageMover.EndpointsClient
+
+			id, err := endpoints.ParseEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
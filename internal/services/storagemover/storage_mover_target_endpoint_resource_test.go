@@ -0,0 +1,176 @@
+package storagemover_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storagemover/sdk/2023-10-01/endpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StorageMoverTargetEndpointResource struct{}
+
+func TestAccStorageMoverTargetEndpoint_storageContainer(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_mover_target_endpoint", "test")
+	r := StorageMoverTargetEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.storageContainer(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageMoverTargetEndpoint_storageShare(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_mover_target_endpoint", "test")
+	r := StorageMoverTargetEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.storageShare(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageMoverTargetEndpoint_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_mover_target_endpoint", "test")
+	r := StorageMoverTargetEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.storageContainer(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccStorageMoverTargetEndpoint_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_mover_target_endpoint", "test")
+	r := StorageMoverTargetEndpointResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.storageContainer(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.storageContainerWithDescription(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r StorageMoverTargetEndpointResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := endpoints.ParseEndpointID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.StorageMover.EndpointsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (r StorageMoverTargetEndpointResource) storageContainer(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_mover_target_endpoint" "test" {
+  name                 = "acctest-smte-%d"
+  storage_mover_id     = azurerm_storage_mover.test.id
+  storage_container_id = azurerm_storage_container.test.resource_manager_id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r StorageMoverTargetEndpointResource) storageContainerWithDescription(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_mover_target_endpoint" "test" {
+  name                 = "acctest-smte-%d"
+  storage_mover_id     = azurerm_storage_mover.test.id
+  storage_container_id = azurerm_storage_container.test.resource_manager_id
+  description          = "Example Target Endpoint Description"
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r StorageMoverTargetEndpointResource) storageShare(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_mover_target_endpoint" "test" {
+  name             = "acctest-smte-%d"
+  storage_mover_id = azurerm_storage_mover.test.id
+  storage_share_id = azurerm_storage_share.test.resource_manager_id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r StorageMoverTargetEndpointResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_mover_target_endpoint" "import" {
+  name                 = azurerm_storage_mover_target_endpoint.test.name
+  storage_mover_id     = azurerm_storage_mover_target_endpoint.test.storage_mover_id
+  storage_container_id = azurerm_storage_mover_target_endpoint.test.storage_container_id
+}
+`, r.storageContainer(data))
+}
+
+func (r StorageMoverTargetEndpointResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account" "test" {
+  name                     = "accsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "acccontainer"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+resource "azurerm_storage_share" "test" {
+  name                 = "accshare"
+  storage_account_name = azurerm_storage_account.test.name
+  quota                = 50
+}
+`, StorageMoverResource{}.basic(data), data.RandomString)
+}